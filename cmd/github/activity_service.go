@@ -1,12 +1,10 @@
 package main
 
 import (
-	"fmt"
+	"time"
 
 	"github.com/codegangsta/cli"
 	"github.com/google/go-github/github"
-	"github.com/jinzhu/now"
-	"github.com/kr/pretty"
 )
 
 var ActivityService = cli.Command{
@@ -24,27 +22,28 @@ var ActivityService = cli.Command{
 				cli.IntFlag{Name: `page`, Usage: `For paginated result sets, page of results to retrieve.`},
 				cli.IntFlag{Name: `per-page`, Usage: `For paginated result sets, the number of results to include per page.`},
 				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
+				cli.IntFlag{Name: `limit`, Usage: `Stop after this many items, fetching the following pages as needed`},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the ListOptions, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				opt := &github.ListOptions{
-					Page:    c.Int("page"),
-					PerPage: c.Int("per-page"),
+				opt := &github.ListOptions{}
+				check(decodeFile(c.String("body-file"), opt))
+				if c.IsSet("page") {
+					opt.Page = c.Int("page")
+				}
+				if c.IsSet("per-page") {
+					opt.PerPage = c.Int("per-page")
 				}
 
-				var items []github.Event
-
-				for {
-					page, res, err := app.gh.Activity.ListEvents(opt)
-					checkResponse(res.Response, err)
-
-					items = append(items, page...)
-					if res.NextPage == 0 || !c.Bool("all") {
-						break
-					}
-					opt.Page = res.NextPage
+				if opt.PerPage == 0 {
+					opt.PerPage = app.profile.PerPage
 				}
 
-				fmt.Printf("%# v", pretty.Formatter(items))
+				paginate(c, "Event", func(page int) (interface{}, *github.Response, error) {
+					opt := *opt
+					opt.Page = page
+					return app.gh.Activity.ListEvents(&opt)
+				})
 			},
 		}, cli.Command{
 			Name:  "list-repository-events",
@@ -56,33 +55,35 @@ var ActivityService = cli.Command{
 				cli.IntFlag{Name: `page`, Usage: `For paginated result sets, page of results to retrieve.`},
 				cli.IntFlag{Name: `per-page`, Usage: `For paginated result sets, the number of results to include per page.`},
 				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
+				cli.IntFlag{Name: `limit`, Usage: `Stop after this many items, fetching the following pages as needed`},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the ListOptions, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 2 {
-					showHelp(c, "list-repository-events", "list-repository-events <owner> <repo>")
+				args := repoArgs(c, 0)
+				if len(args) < 2 {
+					showHelp(c, "list-repository-events", "list-repository-events [<owner> <repo>]")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				opt := &github.ListOptions{
-					Page:    c.Int("page"),
-					PerPage: c.Int("per-page"),
+				owner := args.Get(0)
+				repo := args.Get(1)
+				opt := &github.ListOptions{}
+				check(decodeFile(c.String("body-file"), opt))
+				if c.IsSet("page") {
+					opt.Page = c.Int("page")
+				}
+				if c.IsSet("per-page") {
+					opt.PerPage = c.Int("per-page")
 				}
 
-				var items []github.Event
-
-				for {
-					page, res, err := app.gh.Activity.ListRepositoryEvents(owner, repo, opt)
-					checkResponse(res.Response, err)
-
-					items = append(items, page...)
-					if res.NextPage == 0 || !c.Bool("all") {
-						break
-					}
-					opt.Page = res.NextPage
+				if opt.PerPage == 0 {
+					opt.PerPage = app.profile.PerPage
 				}
 
-				fmt.Printf("%# v", pretty.Formatter(items))
+				paginate(c, "Event", func(page int) (interface{}, *github.Response, error) {
+					opt := *opt
+					opt.Page = page
+					return app.gh.Activity.ListRepositoryEvents(owner, repo, &opt)
+				})
 			},
 		}, cli.Command{
 			Name:  "list-issue-events-for-repository",
@@ -91,36 +92,38 @@ var ActivityService = cli.Command{
 
    GitHub API docs: http://developer.github.com/v3/activity/events/#list-issue-events-for-a-repository`,
 			Flags: []cli.Flag{
-				cli.IntFlag{Name: `per-page`, Usage: `For paginated result sets, the number of results to include per page.`},
 				cli.IntFlag{Name: `page`, Usage: `For paginated result sets, page of results to retrieve.`},
+				cli.IntFlag{Name: `per-page`, Usage: `For paginated result sets, the number of results to include per page.`},
 				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
+				cli.IntFlag{Name: `limit`, Usage: `Stop after this many items, fetching the following pages as needed`},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the ListOptions, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 2 {
-					showHelp(c, "list-issue-events-for-repository", "list-issue-events-for-repository <owner> <repo>")
+				args := repoArgs(c, 0)
+				if len(args) < 2 {
+					showHelp(c, "list-issue-events-for-repository", "list-issue-events-for-repository [<owner> <repo>]")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				opt := &github.ListOptions{
-					Page:    c.Int("page"),
-					PerPage: c.Int("per-page"),
+				owner := args.Get(0)
+				repo := args.Get(1)
+				opt := &github.ListOptions{}
+				check(decodeFile(c.String("body-file"), opt))
+				if c.IsSet("page") {
+					opt.Page = c.Int("page")
+				}
+				if c.IsSet("per-page") {
+					opt.PerPage = c.Int("per-page")
 				}
 
-				var items []github.Event
-
-				for {
-					page, res, err := app.gh.Activity.ListIssueEventsForRepository(owner, repo, opt)
-					checkResponse(res.Response, err)
-
-					items = append(items, page...)
-					if res.NextPage == 0 || !c.Bool("all") {
-						break
-					}
-					opt.Page = res.NextPage
+				if opt.PerPage == 0 {
+					opt.PerPage = app.profile.PerPage
 				}
 
-				fmt.Printf("%# v", pretty.Formatter(items))
+				paginate(c, "Event", func(page int) (interface{}, *github.Response, error) {
+					opt := *opt
+					opt.Page = page
+					return app.gh.Activity.ListIssueEventsForRepository(owner, repo, &opt)
+				})
 			},
 		}, cli.Command{
 			Name:  "list-events-for-repo-network",
@@ -132,33 +135,35 @@ var ActivityService = cli.Command{
 				cli.IntFlag{Name: `page`, Usage: `For paginated result sets, page of results to retrieve.`},
 				cli.IntFlag{Name: `per-page`, Usage: `For paginated result sets, the number of results to include per page.`},
 				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
+				cli.IntFlag{Name: `limit`, Usage: `Stop after this many items, fetching the following pages as needed`},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the ListOptions, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 2 {
-					showHelp(c, "list-events-for-repo-network", "list-events-for-repo-network <owner> <repo>")
+				args := repoArgs(c, 0)
+				if len(args) < 2 {
+					showHelp(c, "list-events-for-repo-network", "list-events-for-repo-network [<owner> <repo>]")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				opt := &github.ListOptions{
-					Page:    c.Int("page"),
-					PerPage: c.Int("per-page"),
+				owner := args.Get(0)
+				repo := args.Get(1)
+				opt := &github.ListOptions{}
+				check(decodeFile(c.String("body-file"), opt))
+				if c.IsSet("page") {
+					opt.Page = c.Int("page")
+				}
+				if c.IsSet("per-page") {
+					opt.PerPage = c.Int("per-page")
 				}
 
-				var items []github.Event
-
-				for {
-					page, res, err := app.gh.Activity.ListEventsForRepoNetwork(owner, repo, opt)
-					checkResponse(res.Response, err)
-
-					items = append(items, page...)
-					if res.NextPage == 0 || !c.Bool("all") {
-						break
-					}
-					opt.Page = res.NextPage
+				if opt.PerPage == 0 {
+					opt.PerPage = app.profile.PerPage
 				}
 
-				fmt.Printf("%# v", pretty.Formatter(items))
+				paginate(c, "Event", func(page int) (interface{}, *github.Response, error) {
+					opt := *opt
+					opt.Page = page
+					return app.gh.Activity.ListEventsForRepoNetwork(owner, repo, &opt)
+				})
 			},
 		}, cli.Command{
 			Name:  "list-events-for-organization",
@@ -170,32 +175,34 @@ var ActivityService = cli.Command{
 				cli.IntFlag{Name: `page`, Usage: `For paginated result sets, page of results to retrieve.`},
 				cli.IntFlag{Name: `per-page`, Usage: `For paginated result sets, the number of results to include per page.`},
 				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
+				cli.IntFlag{Name: `limit`, Usage: `Stop after this many items, fetching the following pages as needed`},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the ListOptions, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 1 {
+				args := c.Args()
+				if len(args) < 1 {
 					showHelp(c, "list-events-for-organization", "list-events-for-organization <org>")
 				}
 
-				org := c.Args().Get(0)
-				opt := &github.ListOptions{
-					Page:    c.Int("page"),
-					PerPage: c.Int("per-page"),
+				org := args.Get(0)
+				opt := &github.ListOptions{}
+				check(decodeFile(c.String("body-file"), opt))
+				if c.IsSet("page") {
+					opt.Page = c.Int("page")
+				}
+				if c.IsSet("per-page") {
+					opt.PerPage = c.Int("per-page")
 				}
 
-				var items []github.Event
-
-				for {
-					page, res, err := app.gh.Activity.ListEventsForOrganization(org, opt)
-					checkResponse(res.Response, err)
-
-					items = append(items, page...)
-					if res.NextPage == 0 || !c.Bool("all") {
-						break
-					}
-					opt.Page = res.NextPage
+				if opt.PerPage == 0 {
+					opt.PerPage = app.profile.PerPage
 				}
 
-				fmt.Printf("%# v", pretty.Formatter(items))
+				paginate(c, "Event", func(page int) (interface{}, *github.Response, error) {
+					opt := *opt
+					opt.Page = page
+					return app.gh.Activity.ListEventsForOrganization(org, &opt)
+				})
 			},
 		}, cli.Command{
 			Name:  "list-events-performed-by-user",
@@ -209,34 +216,36 @@ var ActivityService = cli.Command{
 				cli.IntFlag{Name: `page`, Usage: `For paginated result sets, page of results to retrieve.`},
 				cli.IntFlag{Name: `per-page`, Usage: `For paginated result sets, the number of results to include per page.`},
 				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
+				cli.IntFlag{Name: `limit`, Usage: `Stop after this many items, fetching the following pages as needed`},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the ListOptions, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 1 {
+				args := c.Args()
+				if len(args) < 1 {
 					showHelp(c, "list-events-performed-by-user", "list-events-performed-by-user <user>")
 				}
 
-				user := c.Args().Get(0)
+				user := args.Get(0)
 				publicOnly := c.Bool("public-only")
 
-				opt := &github.ListOptions{
-					Page:    c.Int("page"),
-					PerPage: c.Int("per-page"),
+				opt := &github.ListOptions{}
+				check(decodeFile(c.String("body-file"), opt))
+				if c.IsSet("page") {
+					opt.Page = c.Int("page")
+				}
+				if c.IsSet("per-page") {
+					opt.PerPage = c.Int("per-page")
 				}
 
-				var items []github.Event
-
-				for {
-					page, res, err := app.gh.Activity.ListEventsPerformedByUser(user, publicOnly, opt)
-					checkResponse(res.Response, err)
-
-					items = append(items, page...)
-					if res.NextPage == 0 || !c.Bool("all") {
-						break
-					}
-					opt.Page = res.NextPage
+				if opt.PerPage == 0 {
+					opt.PerPage = app.profile.PerPage
 				}
 
-				fmt.Printf("%# v", pretty.Formatter(items))
+				paginate(c, "Event", func(page int) (interface{}, *github.Response, error) {
+					opt := *opt
+					opt.Page = page
+					return app.gh.Activity.ListEventsPerformedByUser(user, publicOnly, &opt)
+				})
 			},
 		}, cli.Command{
 			Name:  "list-events-recieved-by-user",
@@ -247,37 +256,39 @@ var ActivityService = cli.Command{
    GitHub API docs: http://developer.github.com/v3/activity/events/#list-events-that-a-user-has-received`,
 			Flags: []cli.Flag{
 				cli.BoolFlag{Name: `public-only`, Usage: ``},
-				cli.IntFlag{Name: `per-page`, Usage: `For paginated result sets, the number of results to include per page.`},
 				cli.IntFlag{Name: `page`, Usage: `For paginated result sets, page of results to retrieve.`},
+				cli.IntFlag{Name: `per-page`, Usage: `For paginated result sets, the number of results to include per page.`},
 				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
+				cli.IntFlag{Name: `limit`, Usage: `Stop after this many items, fetching the following pages as needed`},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the ListOptions, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 1 {
+				args := c.Args()
+				if len(args) < 1 {
 					showHelp(c, "list-events-recieved-by-user", "list-events-recieved-by-user <user>")
 				}
 
-				user := c.Args().Get(0)
+				user := args.Get(0)
 				publicOnly := c.Bool("public-only")
 
-				opt := &github.ListOptions{
-					Page:    c.Int("page"),
-					PerPage: c.Int("per-page"),
+				opt := &github.ListOptions{}
+				check(decodeFile(c.String("body-file"), opt))
+				if c.IsSet("page") {
+					opt.Page = c.Int("page")
+				}
+				if c.IsSet("per-page") {
+					opt.PerPage = c.Int("per-page")
 				}
 
-				var items []github.Event
-
-				for {
-					page, res, err := app.gh.Activity.ListEventsRecievedByUser(user, publicOnly, opt)
-					checkResponse(res.Response, err)
-
-					items = append(items, page...)
-					if res.NextPage == 0 || !c.Bool("all") {
-						break
-					}
-					opt.Page = res.NextPage
+				if opt.PerPage == 0 {
+					opt.PerPage = app.profile.PerPage
 				}
 
-				fmt.Printf("%# v", pretty.Formatter(items))
+				paginate(c, "Event", func(page int) (interface{}, *github.Response, error) {
+					opt := *opt
+					opt.Page = page
+					return app.gh.Activity.ListEventsRecievedByUser(user, publicOnly, &opt)
+				})
 			},
 		}, cli.Command{
 			Name:  "list-user-events-for-organization",
@@ -290,33 +301,35 @@ var ActivityService = cli.Command{
 				cli.IntFlag{Name: `page`, Usage: `For paginated result sets, page of results to retrieve.`},
 				cli.IntFlag{Name: `per-page`, Usage: `For paginated result sets, the number of results to include per page.`},
 				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
+				cli.IntFlag{Name: `limit`, Usage: `Stop after this many items, fetching the following pages as needed`},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the ListOptions, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 2 {
+				args := c.Args()
+				if len(args) < 2 {
 					showHelp(c, "list-user-events-for-organization", "list-user-events-for-organization <org> <user>")
 				}
 
-				org := c.Args().Get(0)
-				user := c.Args().Get(1)
-				opt := &github.ListOptions{
-					PerPage: c.Int("per-page"),
-					Page:    c.Int("page"),
+				org := args.Get(0)
+				user := args.Get(1)
+				opt := &github.ListOptions{}
+				check(decodeFile(c.String("body-file"), opt))
+				if c.IsSet("page") {
+					opt.Page = c.Int("page")
+				}
+				if c.IsSet("per-page") {
+					opt.PerPage = c.Int("per-page")
 				}
 
-				var items []github.Event
-
-				for {
-					page, res, err := app.gh.Activity.ListUserEventsForOrganization(org, user, opt)
-					checkResponse(res.Response, err)
-
-					items = append(items, page...)
-					if res.NextPage == 0 || !c.Bool("all") {
-						break
-					}
-					opt.Page = res.NextPage
+				if opt.PerPage == 0 {
+					opt.PerPage = app.profile.PerPage
 				}
 
-				fmt.Printf("%# v", pretty.Formatter(items))
+				paginate(c, "Event", func(page int) (interface{}, *github.Response, error) {
+					opt := *opt
+					opt.Page = page
+					return app.gh.Activity.ListUserEventsForOrganization(org, user, &opt)
+				})
 			},
 		}, cli.Command{
 			Name:  "list-notifications",
@@ -328,17 +341,24 @@ var ActivityService = cli.Command{
 				cli.BoolFlag{Name: `all`, Usage: ``},
 				cli.BoolFlag{Name: `participating`, Usage: ``},
 				cli.StringFlag{Name: `since`, Usage: ``},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the NotificationListOptions, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				opt := &github.NotificationListOptions{
-					All:           c.Bool("all"),
-					Participating: c.Bool("participating"),
-					Since:         now.MustParse(c.String("since")),
+				opt := &github.NotificationListOptions{}
+				check(decodeFile(c.String("body-file"), opt))
+				if c.IsSet("all") {
+					opt.All = c.Bool("all")
+				}
+				if c.IsSet("participating") {
+					opt.Participating = c.Bool("participating")
+				}
+				if c.IsSet("since") {
+					opt.Since = timeFlag(c, "since")
 				}
 
 				result, res, err := app.gh.Activity.ListNotifications(opt)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "Notification", result)
 
 			},
 		}, cli.Command{
@@ -349,26 +369,34 @@ var ActivityService = cli.Command{
 
    GitHub API Docs: https://developer.github.com/v3/activity/notifications/#list-your-notifications-in-a-repository`,
 			Flags: []cli.Flag{
-				cli.StringFlag{Name: `since`, Usage: ``},
 				cli.BoolFlag{Name: `all`, Usage: ``},
 				cli.BoolFlag{Name: `participating`, Usage: ``},
+				cli.StringFlag{Name: `since`, Usage: ``},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the NotificationListOptions, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 2 {
-					showHelp(c, "list-repository-notifications", "list-repository-notifications <owner> <repo>")
+				args := repoArgs(c, 0)
+				if len(args) < 2 {
+					showHelp(c, "list-repository-notifications", "list-repository-notifications [<owner> <repo>]")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				opt := &github.NotificationListOptions{
-					All:           c.Bool("all"),
-					Participating: c.Bool("participating"),
-					Since:         now.MustParse(c.String("since")),
+				owner := args.Get(0)
+				repo := args.Get(1)
+				opt := &github.NotificationListOptions{}
+				check(decodeFile(c.String("body-file"), opt))
+				if c.IsSet("all") {
+					opt.All = c.Bool("all")
+				}
+				if c.IsSet("participating") {
+					opt.Participating = c.Bool("participating")
+				}
+				if c.IsSet("since") {
+					opt.Since = timeFlag(c, "since")
 				}
 
 				result, res, err := app.gh.Activity.ListRepositoryNotifications(owner, repo, opt)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "Notification", result)
 
 			},
 		}, cli.Command{
@@ -381,10 +409,13 @@ var ActivityService = cli.Command{
 				cli.StringFlag{Name: `last-read`, Usage: ``},
 			},
 			Action: func(c *cli.Context) {
-				lastRead := now.MustParse(c.String("last-read"))
+				lastRead := time.Now()
+				if c.IsSet("last-read") {
+					lastRead = timeFlag(c, "last-read")
+				}
 
 				res, err := app.gh.Activity.MarkNotificationsRead(lastRead)
-				checkResponse(res.Response, err)
+				checkResponse(res, err)
 
 			},
 		}, cli.Command{
@@ -398,16 +429,20 @@ var ActivityService = cli.Command{
 				cli.StringFlag{Name: `last-read`, Usage: ``},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 2 {
-					showHelp(c, "mark-repository-notifications-read", "mark-repository-notifications-read <owner> <repo>")
+				args := repoArgs(c, 0)
+				if len(args) < 2 {
+					showHelp(c, "mark-repository-notifications-read", "mark-repository-notifications-read [<owner> <repo>]")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				lastRead := now.MustParse(c.String("last-read"))
+				owner := args.Get(0)
+				repo := args.Get(1)
+				lastRead := time.Now()
+				if c.IsSet("last-read") {
+					lastRead = timeFlag(c, "last-read")
+				}
 
 				res, err := app.gh.Activity.MarkRepositoryNotificationsRead(owner, repo, lastRead)
-				checkResponse(res.Response, err)
+				checkResponse(res, err)
 
 			},
 		}, cli.Command{
@@ -418,15 +453,16 @@ var ActivityService = cli.Command{
    GitHub API Docs: https://developer.github.com/v3/activity/notifications/#view-a-single-thread`,
 			Flags: []cli.Flag{},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 1 {
+				args := c.Args()
+				if len(args) < 1 {
 					showHelp(c, "get-thread", "get-thread <id>")
 				}
 
-				id := c.Args().Get(0)
+				id := args.Get(0)
 
 				result, res, err := app.gh.Activity.GetThread(id)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "Notification", result)
 
 			},
 		}, cli.Command{
@@ -437,14 +473,15 @@ var ActivityService = cli.Command{
    GitHub API Docs: https://developer.github.com/v3/activity/notifications/#mark-a-thread-as-read`,
 			Flags: []cli.Flag{},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 1 {
+				args := c.Args()
+				if len(args) < 1 {
 					showHelp(c, "mark-thread-read", "mark-thread-read <id>")
 				}
 
-				id := c.Args().Get(0)
+				id := args.Get(0)
 
 				res, err := app.gh.Activity.MarkThreadRead(id)
-				checkResponse(res.Response, err)
+				checkResponse(res, err)
 
 			},
 		}, cli.Command{
@@ -456,15 +493,16 @@ var ActivityService = cli.Command{
    GitHub API Docs: https://developer.github.com/v3/activity/notifications/#get-a-thread-subscription`,
 			Flags: []cli.Flag{},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 1 {
+				args := c.Args()
+				if len(args) < 1 {
 					showHelp(c, "get-thread-subscription", "get-thread-subscription <id>")
 				}
 
-				id := c.Args().Get(0)
+				id := args.Get(0)
 
 				result, res, err := app.gh.Activity.GetThreadSubscription(id)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "Subscription", result)
 
 			},
 		}, cli.Command{
@@ -475,27 +513,37 @@ var ActivityService = cli.Command{
 
    GitHub API Docs: https://developer.github.com/v3/activity/notifications/#set-a-thread-subscription`,
 			Flags: []cli.Flag{
-				cli.BoolFlag{Name: `subscribed`, Usage: ``},
+				cli.StringFlag{Name: `created-at`, Usage: ``},
 				cli.BoolFlag{Name: `ignored`, Usage: ``},
 				cli.StringFlag{Name: `reason`, Usage: ``},
-				cli.StringFlag{Name: `created-at`, Usage: ``},
+				cli.BoolFlag{Name: `subscribed`, Usage: ``},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the Subscription, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 1 {
+				args := c.Args()
+				if len(args) < 1 {
 					showHelp(c, "set-thread-subscription", "set-thread-subscription <id>")
 				}
 
-				id := c.Args().Get(0)
-				subscription := &github.Subscription{
-					Subscribed: github.Bool(c.Bool("subscribed")),
-					Ignored:    github.Bool(c.Bool("ignored")),
-					Reason:     github.String(c.String("reason")),
-					CreatedAt:  &github.Timestamp{now.MustParse(c.String("created-at"))},
+				id := args.Get(0)
+				subscription := &github.Subscription{}
+				check(decodeFile(c.String("body-file"), subscription))
+				if c.IsSet("created-at") {
+					subscription.CreatedAt = &github.Timestamp{Time: timeFlag(c, "created-at")}
+				}
+				if c.IsSet("ignored") {
+					subscription.Ignored = github.Bool(c.Bool("ignored"))
+				}
+				if c.IsSet("reason") {
+					subscription.Reason = github.String(c.String("reason"))
+				}
+				if c.IsSet("subscribed") {
+					subscription.Subscribed = github.Bool(c.Bool("subscribed"))
 				}
 
 				result, res, err := app.gh.Activity.SetThreadSubscription(id, subscription)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "Subscription", result)
 
 			},
 		}, cli.Command{
@@ -505,16 +553,21 @@ var ActivityService = cli.Command{
    for the authenticated user.
 
    GitHub API Docs: https://developer.github.com/v3/activity/notifications/#delete-a-thread-subscription`,
-			Flags: []cli.Flag{},
+			Flags: []cli.Flag{
+				cli.BoolFlag{Name: `yes`, Usage: `Don't ask for confirmation`},
+			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 1 {
+				args := c.Args()
+				if len(args) < 1 {
 					showHelp(c, "delete-thread-subscription", "delete-thread-subscription <id>")
 				}
 
-				id := c.Args().Get(0)
+				id := args.Get(0)
+
+				confirm(c, "delete-thread-subscription", id)
 
 				res, err := app.gh.Activity.DeleteThreadSubscription(id)
-				checkResponse(res.Response, err)
+				checkResponse(res, err)
 
 			},
 		}, cli.Command{
@@ -524,36 +577,38 @@ var ActivityService = cli.Command{
 
    GitHub API Docs: https://developer.github.com/v3/activity/starring/#list-stargazers`,
 			Flags: []cli.Flag{
-				cli.IntFlag{Name: `per-page`, Usage: `For paginated result sets, the number of results to include per page.`},
 				cli.IntFlag{Name: `page`, Usage: `For paginated result sets, page of results to retrieve.`},
+				cli.IntFlag{Name: `per-page`, Usage: `For paginated result sets, the number of results to include per page.`},
 				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
+				cli.IntFlag{Name: `limit`, Usage: `Stop after this many items, fetching the following pages as needed`},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the ListOptions, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 2 {
-					showHelp(c, "list-stargazers", "list-stargazers <owner> <repo>")
+				args := repoArgs(c, 0)
+				if len(args) < 2 {
+					showHelp(c, "list-stargazers", "list-stargazers [<owner> <repo>]")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				opt := &github.ListOptions{
-					Page:    c.Int("page"),
-					PerPage: c.Int("per-page"),
+				owner := args.Get(0)
+				repo := args.Get(1)
+				opt := &github.ListOptions{}
+				check(decodeFile(c.String("body-file"), opt))
+				if c.IsSet("page") {
+					opt.Page = c.Int("page")
+				}
+				if c.IsSet("per-page") {
+					opt.PerPage = c.Int("per-page")
 				}
 
-				var items []github.User
-
-				for {
-					page, res, err := app.gh.Activity.ListStargazers(owner, repo, opt)
-					checkResponse(res.Response, err)
-
-					items = append(items, page...)
-					if res.NextPage == 0 || !c.Bool("all") {
-						break
-					}
-					opt.Page = res.NextPage
+				if opt.PerPage == 0 {
+					opt.PerPage = app.profile.PerPage
 				}
 
-				fmt.Printf("%# v", pretty.Formatter(items))
+				paginate(c, "User", func(page int) (interface{}, *github.Response, error) {
+					opt := *opt
+					opt.Page = page
+					return app.gh.Activity.ListStargazers(owner, repo, &opt)
+				})
 			},
 		}, cli.Command{
 			Name:  "list-starred",
@@ -563,39 +618,47 @@ var ActivityService = cli.Command{
 
    GitHub API docs: http://developer.github.com/v3/activity/starring/#list-repositories-being-starred`,
 			Flags: []cli.Flag{
-				cli.StringFlag{Name: `sort`, Usage: `How to sort the repository list.  Possible values are: created, updated,
-pushed, full_name.  Default is "full_name".`},
+				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
 				cli.StringFlag{Name: `direction`, Usage: `Direction in which to sort repositories.  Possible values are: asc, desc.
 Default is "asc" when sort is "full_name", otherwise default is "desc".`},
+				cli.IntFlag{Name: `limit`, Usage: `Stop after this many items, fetching the following pages as needed`},
 				cli.IntFlag{Name: `page`, Usage: `For paginated result sets, page of results to retrieve.`},
 				cli.IntFlag{Name: `per-page`, Usage: `For paginated result sets, the number of results to include per page.`},
-				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
+				cli.StringFlag{Name: `sort`, Usage: `How to sort the repository list.  Possible values are: created, updated,
+pushed, full_name.  Default is "full_name".`},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the ActivityListStarredOptions, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 1 {
+				args := c.Args()
+				if len(args) < 1 {
 					showHelp(c, "list-starred", "list-starred <user>")
 				}
 
-				user := c.Args().Get(0)
-				opt := &github.ActivityListStarredOptions{
-					Sort:      c.String("sort"),
-					Direction: c.String("direction"),
+				user := args.Get(0)
+				opt := &github.ActivityListStarredOptions{}
+				check(decodeFile(c.String("body-file"), opt))
+				if c.IsSet("direction") {
+					opt.Direction = c.String("direction")
+				}
+				if c.IsSet("sort") {
+					opt.Sort = c.String("sort")
+				}
+				if c.IsSet("page") {
+					opt.Page = c.Int("page")
+				}
+				if c.IsSet("per-page") {
+					opt.PerPage = c.Int("per-page")
 				}
 
-				var items []github.StarredRepository
-
-				for {
-					page, res, err := app.gh.Activity.ListStarred(user, opt)
-					checkResponse(res.Response, err)
-
-					items = append(items, page...)
-					if res.NextPage == 0 || !c.Bool("all") {
-						break
-					}
-					opt.Page = res.NextPage
+				if opt.PerPage == 0 {
+					opt.PerPage = app.profile.PerPage
 				}
 
-				fmt.Printf("%# v", pretty.Formatter(items))
+				paginate(c, "StarredRepository", func(page int) (interface{}, *github.Response, error) {
+					opt := *opt
+					opt.Page = page
+					return app.gh.Activity.ListStarred(user, &opt)
+				})
 			},
 		}, cli.Command{
 			Name:  "is-starred",
@@ -605,16 +668,17 @@ Default is "asc" when sort is "full_name", otherwise default is "desc".`},
    GitHub API docs: https://developer.github.com/v3/activity/starring/#check-if-you-are-starring-a-repository`,
 			Flags: []cli.Flag{},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 2 {
-					showHelp(c, "is-starred", "is-starred <owner> <repo>")
+				args := repoArgs(c, 0)
+				if len(args) < 2 {
+					showHelp(c, "is-starred", "is-starred [<owner> <repo>]")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
+				owner := args.Get(0)
+				repo := args.Get(1)
 
 				result, res, err := app.gh.Activity.IsStarred(owner, repo)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "bool", result)
 
 			},
 		}, cli.Command{
@@ -625,15 +689,16 @@ Default is "asc" when sort is "full_name", otherwise default is "desc".`},
    GitHub API docs: https://developer.github.com/v3/activity/starring/#star-a-repository`,
 			Flags: []cli.Flag{},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 2 {
-					showHelp(c, "star", "star <owner> <repo>")
+				args := repoArgs(c, 0)
+				if len(args) < 2 {
+					showHelp(c, "star", "star [<owner> <repo>]")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
+				owner := args.Get(0)
+				repo := args.Get(1)
 
 				res, err := app.gh.Activity.Star(owner, repo)
-				checkResponse(res.Response, err)
+				checkResponse(res, err)
 
 			},
 		}, cli.Command{
@@ -644,15 +709,16 @@ Default is "asc" when sort is "full_name", otherwise default is "desc".`},
    GitHub API docs: https://developer.github.com/v3/activity/starring/#unstar-a-repository`,
 			Flags: []cli.Flag{},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 2 {
-					showHelp(c, "unstar", "unstar <owner> <repo>")
+				args := repoArgs(c, 0)
+				if len(args) < 2 {
+					showHelp(c, "unstar", "unstar [<owner> <repo>]")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
+				owner := args.Get(0)
+				repo := args.Get(1)
 
 				res, err := app.gh.Activity.Unstar(owner, repo)
-				checkResponse(res.Response, err)
+				checkResponse(res, err)
 
 			},
 		}, cli.Command{
@@ -665,33 +731,35 @@ Default is "asc" when sort is "full_name", otherwise default is "desc".`},
 				cli.IntFlag{Name: `page`, Usage: `For paginated result sets, page of results to retrieve.`},
 				cli.IntFlag{Name: `per-page`, Usage: `For paginated result sets, the number of results to include per page.`},
 				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
+				cli.IntFlag{Name: `limit`, Usage: `Stop after this many items, fetching the following pages as needed`},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the ListOptions, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 2 {
-					showHelp(c, "list-watchers", "list-watchers <owner> <repo>")
+				args := repoArgs(c, 0)
+				if len(args) < 2 {
+					showHelp(c, "list-watchers", "list-watchers [<owner> <repo>]")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				opt := &github.ListOptions{
-					Page:    c.Int("page"),
-					PerPage: c.Int("per-page"),
+				owner := args.Get(0)
+				repo := args.Get(1)
+				opt := &github.ListOptions{}
+				check(decodeFile(c.String("body-file"), opt))
+				if c.IsSet("page") {
+					opt.Page = c.Int("page")
+				}
+				if c.IsSet("per-page") {
+					opt.PerPage = c.Int("per-page")
 				}
 
-				var items []github.User
-
-				for {
-					page, res, err := app.gh.Activity.ListWatchers(owner, repo, opt)
-					checkResponse(res.Response, err)
-
-					items = append(items, page...)
-					if res.NextPage == 0 || !c.Bool("all") {
-						break
-					}
-					opt.Page = res.NextPage
+				if opt.PerPage == 0 {
+					opt.PerPage = app.profile.PerPage
 				}
 
-				fmt.Printf("%# v", pretty.Formatter(items))
+				paginate(c, "User", func(page int) (interface{}, *github.Response, error) {
+					opt := *opt
+					opt.Page = page
+					return app.gh.Activity.ListWatchers(owner, repo, &opt)
+				})
 			},
 		}, cli.Command{
 			Name:  "list-watched",
@@ -702,15 +770,16 @@ Default is "asc" when sort is "full_name", otherwise default is "desc".`},
    GitHub API Docs: https://developer.github.com/v3/activity/watching/#list-repositories-being-watched`,
 			Flags: []cli.Flag{},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 1 {
+				args := c.Args()
+				if len(args) < 1 {
 					showHelp(c, "list-watched", "list-watched <user>")
 				}
 
-				user := c.Args().Get(0)
+				user := args.Get(0)
 
 				result, res, err := app.gh.Activity.ListWatched(user)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "Repository", result)
 
 			},
 		}, cli.Command{
@@ -723,16 +792,17 @@ Default is "asc" when sort is "full_name", otherwise default is "desc".`},
    GitHub API Docs: https://developer.github.com/v3/activity/watching/#get-a-repository-subscription`,
 			Flags: []cli.Flag{},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 2 {
-					showHelp(c, "get-repository-subscription", "get-repository-subscription <owner> <repo>")
+				args := repoArgs(c, 0)
+				if len(args) < 2 {
+					showHelp(c, "get-repository-subscription", "get-repository-subscription [<owner> <repo>]")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
+				owner := args.Get(0)
+				repo := args.Get(1)
 
 				result, res, err := app.gh.Activity.GetRepositorySubscription(owner, repo)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "Subscription", result)
 
 			},
 		}, cli.Command{
@@ -743,28 +813,38 @@ Default is "asc" when sort is "full_name", otherwise default is "desc".`},
 
    GitHub API Docs: https://developer.github.com/v3/activity/watching/#set-a-repository-subscription`,
 			Flags: []cli.Flag{
+				cli.StringFlag{Name: `created-at`, Usage: ``},
 				cli.BoolFlag{Name: `ignored`, Usage: ``},
 				cli.StringFlag{Name: `reason`, Usage: ``},
-				cli.StringFlag{Name: `created-at`, Usage: ``},
 				cli.BoolFlag{Name: `subscribed`, Usage: ``},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the Subscription, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 2 {
-					showHelp(c, "set-repository-subscription", "set-repository-subscription <owner> <repo>")
+				args := repoArgs(c, 0)
+				if len(args) < 2 {
+					showHelp(c, "set-repository-subscription", "set-repository-subscription [<owner> <repo>]")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				subscription := &github.Subscription{
-					Reason:     github.String(c.String("reason")),
-					CreatedAt:  &github.Timestamp{now.MustParse(c.String("created-at"))},
-					Subscribed: github.Bool(c.Bool("subscribed")),
-					Ignored:    github.Bool(c.Bool("ignored")),
+				owner := args.Get(0)
+				repo := args.Get(1)
+				subscription := &github.Subscription{}
+				check(decodeFile(c.String("body-file"), subscription))
+				if c.IsSet("created-at") {
+					subscription.CreatedAt = &github.Timestamp{Time: timeFlag(c, "created-at")}
+				}
+				if c.IsSet("ignored") {
+					subscription.Ignored = github.Bool(c.Bool("ignored"))
+				}
+				if c.IsSet("reason") {
+					subscription.Reason = github.String(c.String("reason"))
+				}
+				if c.IsSet("subscribed") {
+					subscription.Subscribed = github.Bool(c.Bool("subscribed"))
 				}
 
 				result, res, err := app.gh.Activity.SetRepositorySubscription(owner, repo, subscription)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "Subscription", result)
 
 			},
 		}, cli.Command{
@@ -774,17 +854,22 @@ Default is "asc" when sort is "full_name", otherwise default is "desc".`},
    repository for the authenticated user.
 
    GitHub API Docs: https://developer.github.com/v3/activity/watching/#delete-a-repository-subscription`,
-			Flags: []cli.Flag{},
+			Flags: []cli.Flag{
+				cli.BoolFlag{Name: `yes`, Usage: `Don't ask for confirmation`},
+			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 2 {
-					showHelp(c, "delete-repository-subscription", "delete-repository-subscription <owner> <repo>")
+				args := repoArgs(c, 0)
+				if len(args) < 2 {
+					showHelp(c, "delete-repository-subscription", "delete-repository-subscription [<owner> <repo>]")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
+				owner := args.Get(0)
+				repo := args.Get(1)
+
+				confirm(c, "delete-repository-subscription", repo)
 
 				res, err := app.gh.Activity.DeleteRepositorySubscription(owner, repo)
-				checkResponse(res.Response, err)
+				checkResponse(res, err)
 
 			},
 		},
@@ -0,0 +1,260 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/codegangsta/cli"
+	"golang.org/x/crypto/ssh/terminal"
+	"gopkg.in/yaml.v2"
+)
+
+// Tokens are stored per API host, either in the credentials file or through
+// the credential helper of the profile.
+
+func credentialsPath() string {
+	return filepath.Join(filepath.Dir(configPath()), "credentials.yaml")
+}
+
+func loadCredentials() (map[string]string, error) {
+	creds := map[string]string{}
+
+	data, err := ioutil.ReadFile(credentialsPath())
+	if os.IsNotExist(err) {
+		return creds, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := yaml.Unmarshal(data, &creds); err != nil {
+		return nil, fmt.Errorf("%s: %v", credentialsPath(), err)
+	}
+	return creds, nil
+}
+
+func saveCredentials(creds map[string]string) error {
+	data, err := yaml.Marshal(creds)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(credentialsPath()), 0700); err != nil {
+		return err
+	}
+	// WriteFile keeps the mode of an existing file
+	if err := ioutil.WriteFile(credentialsPath(), data, 0600); err != nil {
+		return err
+	}
+	return os.Chmod(credentialsPath(), 0600)
+}
+
+// loadToken returns the stored token for host, or "" when there is none. helper
+// is the credential helper of the profile, if any.
+func loadToken(helper, host string) (string, error) {
+	if helper != "" {
+		out, err := credentialHelper(helper, "get", "host="+host)
+		if err != nil {
+			return "", err
+		}
+		return out["password"], nil
+	}
+
+	creds, err := loadCredentials()
+	if err != nil {
+		return "", err
+	}
+	return creds[host], nil
+}
+
+func storeToken(helper, host, login, token string) error {
+	if helper != "" {
+		_, err := credentialHelper(helper, "store", "host="+host, "username="+login, "password="+token)
+		return err
+	}
+
+	creds, err := loadCredentials()
+	if err != nil {
+		return err
+	}
+	creds[host] = token
+	return saveCredentials(creds)
+}
+
+func eraseToken(helper, host string) error {
+	if helper != "" {
+		_, err := credentialHelper(helper, "erase", "host="+host)
+		return err
+	}
+
+	creds, err := loadCredentials()
+	if err != nil {
+		return err
+	}
+	delete(creds, host)
+	return saveCredentials(creds)
+}
+
+// credentialHelper runs helper the way git does: "!cmd" runs cmd in a shell, an
+// absolute path runs as is, and any other name runs git credential-<name>.
+// Attributes are exchanged as key=value lines on stdin and stdout.
+func credentialHelper(helper, action string, attrs ...string) (map[string]string, error) {
+	command := helper
+	switch {
+	case strings.HasPrefix(helper, "!"):
+		command = helper[1:]
+	case !filepath.IsAbs(helper):
+		command = "git credential-" + helper
+	}
+
+	var in bytes.Buffer
+	fmt.Fprintln(&in, "protocol=https")
+	for _, attr := range attrs {
+		fmt.Fprintln(&in, attr)
+	}
+	fmt.Fprintln(&in)
+
+	cmd := exec.Command("sh", "-c", command+" "+action)
+	cmd.Stdin = &in
+	cmd.Stderr = os.Stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("credential helper %q: %v", helper, err)
+	}
+
+	result := map[string]string{}
+	scanner := bufio.NewScanner(bytes.NewReader(out))
+	for scanner.Scan() {
+		if kv := strings.SplitN(scanner.Text(), "=", 2); len(kv) == 2 {
+			result[kv[0]] = kv[1]
+		}
+	}
+	return result, scanner.Err()
+}
+
+// readToken prompts for a token without echoing it on a terminal, and reads
+// it from stdin otherwise, so that it can be piped in.
+func readToken() (string, error) {
+	if !isTerminal(os.Stdin) {
+		data, err := ioutil.ReadAll(os.Stdin)
+		return strings.TrimSpace(string(data)), err
+	}
+
+	fmt.Fprint(os.Stderr, "Paste a personal access token: ")
+	data, err := terminal.ReadPassword(int(os.Stdin.Fd()))
+	fmt.Fprintln(os.Stderr)
+	return strings.TrimSpace(string(data)), err
+}
+
+// refuseDryRun exits when command, which needs the user returned by the API,
+// runs with --dry-run: no request is sent then, and the response is empty.
+func refuseDryRun(command string) {
+	if app.dryRun.enabled {
+		exit(fmt.Errorf("%s can't run with --dry-run, since it needs the response of the API", command))
+	}
+}
+
+var authCommand = cli.Command{
+	Name:     "auth",
+	Usage:    "log in and out of the API host of the profile",
+	HideHelp: true,
+	Action:   fixHelp,
+	Subcommands: []cli.Command{
+		cli.Command{
+			Name:  "login",
+			Usage: "store a token read from stdin, prompted for, or obtained with --device, once verified",
+			Flags: []cli.Flag{
+				cli.BoolFlag{Name: "device", Usage: "Log in through the website with the OAuth device flow"},
+				cli.StringFlag{Name: "client-id", Usage: "Client ID of the OAuth app used by --device", EnvVar: "GITHUB_CLIENT_ID"},
+				cli.StringFlag{Name: "scopes", Value: "repo,read:org,gist", Usage: "Scopes requested by --device"},
+			},
+			Action: func(c *cli.Context) {
+				refuseDryRun("auth login")
+
+				var token string
+				var err error
+				if c.Bool("device") {
+					if c.String("client-id") == "" {
+						exit(errors.New("--device needs the --client-id of an OAuth app with the device flow enabled"))
+					}
+					flow := &deviceFlow{
+						client:   http.DefaultClient,
+						webURL:   webURL(app.gh.BaseURL),
+						clientID: c.String("client-id"),
+						scopes:   c.String("scopes"),
+						sleep:    time.Sleep,
+					}
+					token, err = flow.token(os.Stderr)
+				} else {
+					token, err = readToken()
+				}
+				check(err)
+				if token == "" {
+					exit(errors.New("No token given"))
+				}
+
+				app.authenticate(staticToken(token))
+				user, res, err := app.gh.Users.Get("")
+				checkResponse(res, err)
+
+				check(storeToken(app.profile.CredentialHelper, app.gh.BaseURL.Host, *user.Login, token))
+				fmt.Fprintf(os.Stderr, "Logged in to %s as %s\n", app.gh.BaseURL.Host, *user.Login)
+			},
+		},
+		cli.Command{
+			Name:  "logout",
+			Usage: "remove the stored token",
+			Action: func(c *cli.Context) {
+				check(eraseToken(app.profile.CredentialHelper, app.gh.BaseURL.Host))
+				fmt.Fprintf(os.Stderr, "Logged out of %s\n", app.gh.BaseURL.Host)
+			},
+		},
+		cli.Command{
+			Name:  "status",
+			Usage: "show the user, scopes and expiry of the token in use",
+			Action: func(c *cli.Context) {
+				refuseDryRun("auth status")
+				if app.tokens == nil {
+					exit(&cliError{Code: exitUnauthorized, Message: "Not logged in to " + app.gh.BaseURL.Host})
+				}
+
+				user, res, err := app.gh.Users.Get("")
+				checkResponse(res, err)
+
+				scopes := res.Header.Get("X-OAuth-Scopes")
+				if scopes == "" {
+					scopes = "none"
+				}
+				expires := res.Header.Get("GitHub-Authentication-Token-Expiration")
+				if expires == "" {
+					expires = "never"
+				}
+				fmt.Printf("host:    %s\nuser:    %s\nscopes:  %s\nexpires: %s\n", app.gh.BaseURL.Host, *user.Login, scopes, expires)
+			},
+		},
+		cli.Command{
+			Name:  "token",
+			Usage: "print the token in use",
+			Action: func(c *cli.Context) {
+				if app.tokens == nil {
+					exit(&cliError{Code: exitUnauthorized, Message: "Not logged in to " + app.gh.BaseURL.Host})
+				}
+				token, err := app.tokens.Token()
+				check(err)
+				fmt.Println(token.AccessToken)
+			},
+		},
+	},
+}
+
+func init() {
+	app.cli.Commands = append(app.cli.Commands, authCommand)
+}
@@ -0,0 +1,250 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"net/http/httputil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/codegangsta/cli"
+)
+
+// Limits of the cache. Larger responses are streamed without being stored, and
+// entries are evicted oldest first once they expire or the cache grows too big.
+const (
+	maxCacheEntry = 1 << 20
+	maxCacheSize  = 50 << 20
+	maxCacheAge   = 7 * 24 * time.Hour
+)
+
+// cacheTransport keeps JSON responses of the API carrying an ETag or
+// Last-Modified on disk, and revalidates them with conditional requests.
+// GitHub doesn't count 304 responses against the rate limit, so polling the
+// same resource is cheap. It is enabled with --cache.
+type cacheTransport struct {
+	base    http.RoundTripper
+	dir     string
+	host    string
+	enabled bool
+}
+
+func (t *cacheTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// Downloads from other hosts, such as release assets, are left alone
+	if !t.enabled || req.Method != "GET" || req.Header.Get("Range") != "" || req.URL.Host != t.host {
+		return t.base.RoundTrip(req)
+	}
+
+	path := t.path(req)
+	cached, err := readCached(path, req)
+	if err != nil {
+		// A corrupt or unreadable entry is just a miss
+		cached = nil
+	}
+
+	conditional := req
+	if cached != nil {
+		conditional = withBody(req, nil)
+		conditional.Header = make(http.Header, len(req.Header)+2)
+		for k, v := range req.Header {
+			conditional.Header[k] = v
+		}
+		if etag := cached.Header.Get("ETag"); etag != "" {
+			conditional.Header.Set("If-None-Match", etag)
+		}
+		if modified := cached.Header.Get("Last-Modified"); modified != "" {
+			conditional.Header.Set("If-Modified-Since", modified)
+		}
+	}
+
+	res, err := t.base.RoundTrip(conditional)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode == http.StatusNotModified && cached != nil {
+		res.Body.Close()
+		// Rate limit headers of the 304 are more recent
+		for k, v := range res.Header {
+			cached.Header[k] = v
+		}
+		// Keep the entry from being evicted as if it were unused
+		now := time.Now()
+		os.Chtimes(path, now, now)
+		return cached, nil
+	}
+
+	if res.StatusCode != http.StatusOK || (res.Header.Get("ETag") == "" && res.Header.Get("Last-Modified") == "") {
+		return res, nil
+	}
+	if !isJSON(res.Header.Get("Content-Type")) || res.ContentLength > maxCacheEntry {
+		return res, nil
+	}
+
+	// The length may be unknown, so read one byte more than an entry can hold
+	// to tell whether the body fits
+	body, err := ioutil.ReadAll(io.LimitReader(res.Body, maxCacheEntry+1))
+	if err != nil {
+		res.Body.Close()
+		return nil, err
+	}
+	if len(body) > maxCacheEntry {
+		res.Body = struct {
+			io.Reader
+			io.Closer
+		}{io.MultiReader(bytes.NewReader(body), res.Body), res.Body}
+		return res, nil
+	}
+	res.Body.Close()
+	res.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	dump, err := httputil.DumpResponse(res, true)
+	if err != nil {
+		return nil, err
+	}
+	if err := writeCached(path, dump); err != nil {
+		fmt.Fprintln(os.Stderr, "Could not write to the cache:", err)
+	}
+	if err := pruneCache(t.dir, time.Now()); err != nil {
+		fmt.Fprintln(os.Stderr, "Could not prune the cache:", err)
+	}
+	return res, nil
+}
+
+// isJSON tells whether contentType is JSON, such as application/json or one of
+// the application/vnd.github.*+json media types.
+func isJSON(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	return err == nil && (mediaType == "application/json" || strings.HasSuffix(mediaType, "+json"))
+}
+
+// path names the entry for req. Responses depend on who's asking, so the
+// credentials are part of the key along with the URL and media type.
+func (t *cacheTransport) path(req *http.Request) string {
+	h := sha256.New()
+	fmt.Fprintln(h, req.URL.String())
+	fmt.Fprintln(h, req.Header.Get("Accept"))
+	fmt.Fprintln(h, req.Header.Get("Authorization"))
+	return filepath.Join(t.dir, hex.EncodeToString(h.Sum(nil)))
+}
+
+// readCached returns the response stored at path, or nil when there is none.
+func readCached(path string, req *http.Request) (*http.Response, error) {
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return http.ReadResponse(bufio.NewReader(bytes.NewReader(data)), req)
+}
+
+// writeCached goes through a temporary file so concurrent invocations never
+// read a partial entry.
+func writeCached(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempFile(filepath.Dir(path), ".tmp")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// pruneCache removes the entries of dir not used for maxCacheAge, then the
+// least recently used ones until the cache fits in maxCacheSize.
+func pruneCache(dir string, now time.Time) error {
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	sort.Sort(byModTime(entries))
+
+	var size int64
+	for _, entry := range entries {
+		size += entry.Size()
+	}
+	for _, entry := range entries {
+		if now.Sub(entry.ModTime()) < maxCacheAge && size <= maxCacheSize {
+			break
+		}
+		if err := os.Remove(filepath.Join(dir, entry.Name())); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		size -= entry.Size()
+	}
+	return nil
+}
+
+// byModTime sorts files oldest first
+type byModTime []os.FileInfo
+
+func (f byModTime) Len() int           { return len(f) }
+func (f byModTime) Less(i, j int) bool { return f[i].ModTime().Before(f[j].ModTime()) }
+func (f byModTime) Swap(i, j int)      { f[i], f[j] = f[j], f[i] }
+
+// cacheDir follows the XDG base directory spec.
+func cacheDir() string {
+	dir := os.Getenv("XDG_CACHE_HOME")
+	if dir == "" {
+		dir = filepath.Join(os.Getenv("HOME"), ".cache")
+	}
+	return filepath.Join(dir, "github-cli")
+}
+
+var cacheCommand = cli.Command{
+	Name:     "cache",
+	Usage:    "manage the on-disk cache of API responses",
+	HideHelp: true,
+	Action:   fixHelp,
+	Subcommands: []cli.Command{
+		cli.Command{
+			Name:  "clear",
+			Usage: "remove all cached responses",
+			Action: func(c *cli.Context) {
+				check(os.RemoveAll(app.cache.dir))
+			},
+		},
+		cli.Command{
+			Name:  "stats",
+			Usage: "show the location, number of entries and size of the cache",
+			Action: func(c *cli.Context) {
+				entries, err := ioutil.ReadDir(app.cache.dir)
+				if err != nil && !os.IsNotExist(err) {
+					check(err)
+				}
+
+				var size int64
+				for _, entry := range entries {
+					size += entry.Size()
+				}
+				fmt.Printf("directory: %s\nentries:   %d\nsize:      %s\n", app.cache.dir, len(entries), humanBytes(size))
+			},
+		},
+	},
+}
+
+func init() {
+	app.cli.Commands = append(app.cli.Commands, cacheCommand)
+}
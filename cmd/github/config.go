@@ -0,0 +1,245 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/codegangsta/cli"
+	"gopkg.in/yaml.v2"
+)
+
+// profile holds the settings of a named profile of the config file. Settings
+// of the selected profile are overridden by environment variables, and those
+// by flags.
+type profile struct {
+	Token     string `yaml:"token,omitempty"`
+	BaseURL   string `yaml:"base_url,omitempty"`
+	UploadURL string `yaml:"upload_url,omitempty"`
+	Owner     string `yaml:"owner,omitempty"`
+	Repo      string `yaml:"repo,omitempty"`
+	Output    string `yaml:"output,omitempty"`
+	PerPage   int    `yaml:"per_page,omitempty"`
+
+	CredentialHelper string `yaml:"credential_helper,omitempty"`
+}
+
+type config struct {
+	Profiles map[string]*profile `yaml:"profiles"`
+}
+
+// configPath follows the XDG base directory spec, like cacheDir.
+func configPath() string {
+	dir := os.Getenv("XDG_CONFIG_HOME")
+	if dir == "" {
+		dir = filepath.Join(os.Getenv("HOME"), ".config")
+	}
+	return filepath.Join(dir, "github-cli", "config.yaml")
+}
+
+// loadConfig reads the config file, which is optional.
+func loadConfig() (*config, error) {
+	conf := &config{Profiles: map[string]*profile{}}
+
+	data, err := ioutil.ReadFile(configPath())
+	if os.IsNotExist(err) {
+		return conf, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := yaml.Unmarshal(data, conf); err != nil {
+		return nil, fmt.Errorf("%s: %v", configPath(), err)
+	}
+	if conf.Profiles == nil {
+		conf.Profiles = map[string]*profile{}
+	}
+	return conf, nil
+}
+
+// save writes the config file, readable only by the user since it may hold
+// tokens.
+func (conf *config) save() error {
+	data, err := yaml.Marshal(conf)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(configPath()), 0700); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(configPath(), data, 0600)
+}
+
+// resolveProfile returns the settings in effect: the selected profile, then
+// environment variables, then flags. Commands with a --per-page flag let it
+// override PerPage.
+func resolveProfile(c *cli.Context) (profile, error) {
+	var p profile
+
+	conf, err := loadConfig()
+	if err != nil {
+		return p, err
+	}
+
+	name := c.GlobalString("profile")
+	if selected, ok := conf.Profiles[name]; ok {
+		p = *selected
+	} else if c.GlobalIsSet("profile") || os.Getenv("GITHUB_PROFILE") != "" {
+		// Let config set create it
+		if c.Args().First() != "config" {
+			return p, fmt.Errorf("Unknown profile %q in %s", name, configPath())
+		}
+	}
+
+	if token := os.Getenv("GITHUB_API_TOKEN"); token != "" {
+		p.Token = token
+	}
+	if api := os.Getenv("GITHUB_API_URL"); api != "" {
+		p.BaseURL = api
+	}
+	if upload := os.Getenv("GITHUB_UPLOAD_URL"); upload != "" {
+		p.UploadURL = upload
+	}
+	// Prefixed since GitHub Actions sets GITHUB_OUTPUT and GITHUB_REPOSITORY
+	// for its own use
+	if owner := os.Getenv("GITHUB_CLI_OWNER"); owner != "" {
+		p.Owner = owner
+	}
+	if repo := os.Getenv("GITHUB_CLI_REPO"); repo != "" {
+		p.Repo = repo
+	}
+	if output := os.Getenv("GITHUB_CLI_OUTPUT"); output != "" {
+		p.Output = output
+	}
+	if perPage := os.Getenv("GITHUB_CLI_PER_PAGE"); perPage != "" {
+		n, err := strconv.Atoi(perPage)
+		if err != nil || n <= 0 {
+			return p, fmt.Errorf("Invalid GITHUB_CLI_PER_PAGE %q, expected a positive number", perPage)
+		}
+		p.PerPage = n
+	}
+
+	if api := c.GlobalString("api-url"); api != "" {
+		p.BaseURL = api
+	}
+	if upload := c.GlobalString("upload-url"); upload != "" {
+		p.UploadURL = upload
+	}
+	if output := c.GlobalString("output"); output != "" {
+		p.Output = output
+	}
+
+	return p, nil
+}
+
+// profileField returns the setting of p named key, as spelled in the file.
+func profileField(p *profile, key string) (reflect.Value, error) {
+	v := reflect.ValueOf(p).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		if strings.Split(v.Type().Field(i).Tag.Get("yaml"), ",")[0] == key {
+			return v.Field(i), nil
+		}
+	}
+	return reflect.Value{}, fmt.Errorf("Unknown setting %q, expected one of %s", key, strings.Join(profileKeys(), ", "))
+}
+
+func profileKeys() []string {
+	var keys []string
+	t := reflect.TypeOf(profile{})
+	for i := 0; i < t.NumField(); i++ {
+		keys = append(keys, strings.Split(t.Field(i).Tag.Get("yaml"), ",")[0])
+	}
+	return keys
+}
+
+var configCommand = cli.Command{
+	Name:     "config",
+	Usage:    "manage the profiles of the config file",
+	HideHelp: true,
+	Action:   fixHelp,
+	Subcommands: []cli.Command{
+		cli.Command{
+			Name:  "get",
+			Usage: "print a setting of the selected profile",
+			Action: func(c *cli.Context) {
+				if len(c.Args()) < 1 {
+					showHelp(c, "get", "get <key>")
+				}
+
+				conf, err := loadConfig()
+				check(err)
+				p, ok := conf.Profiles[c.GlobalString("profile")]
+				if !ok {
+					p = &profile{}
+				}
+
+				field, err := profileField(p, c.Args().Get(0))
+				check(err)
+				fmt.Println(field.Interface())
+			},
+		},
+		cli.Command{
+			Name:  "set",
+			Usage: "change a setting of the selected profile, creating it if needed",
+			Action: func(c *cli.Context) {
+				if len(c.Args()) < 2 {
+					showHelp(c, "set", "set <key> <value>")
+				}
+
+				conf, err := loadConfig()
+				check(err)
+				name := c.GlobalString("profile")
+				if conf.Profiles[name] == nil {
+					conf.Profiles[name] = &profile{}
+				}
+
+				field, err := profileField(conf.Profiles[name], c.Args().Get(0))
+				check(err)
+				check(setField(field, c.Args().Get(1)))
+				check(conf.save())
+			},
+		},
+		cli.Command{
+			Name:  "list",
+			Usage: "print the settings of all profiles, with tokens hidden",
+			Action: func(c *cli.Context) {
+				conf, err := loadConfig()
+				check(err)
+
+				var names []string
+				for name := range conf.Profiles {
+					names = append(names, name)
+				}
+				sort.Strings(names)
+
+				for _, name := range names {
+					for _, key := range profileKeys() {
+						field, _ := profileField(conf.Profiles[name], key)
+						if isZero(field) {
+							continue
+						}
+						value := fmt.Sprint(field.Interface())
+						if key == "token" {
+							value = "********"
+						}
+						fmt.Printf("%s.%s=%s\n", name, key, value)
+					}
+				}
+			},
+		},
+	},
+}
+
+func isZero(v reflect.Value) bool {
+	return v.Interface() == reflect.Zero(v.Type()).Interface()
+}
+
+func init() {
+	app.cli.Commands = append(app.cli.Commands, configCommand)
+}
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/codegangsta/cli"
+)
+
+// confirm makes the user type expected before a destructive command runs.
+// --yes skips the question, and is required when stdin isn't a terminal so
+// that scripts never hang or go ahead by accident. Nothing is at stake with
+// --dry-run.
+func confirm(c *cli.Context, command, expected string) {
+	if c.Bool("yes") || c.GlobalBool("dry-run") {
+		return
+	}
+	if !isTerminal(os.Stdin) {
+		exit(fmt.Errorf("%s asks for confirmation, pass --yes when not running interactively", command))
+	}
+
+	fmt.Fprintf(os.Stderr, "%s is destructive, type %q to confirm: ", command, expected)
+	answer, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil || strings.TrimSpace(answer) != expected {
+		exit(errors.New("Aborted"))
+	}
+}
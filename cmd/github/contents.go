@@ -0,0 +1,49 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/codegangsta/cli"
+	"github.com/google/go-github/github"
+)
+
+// renderFileOrDir prints the decoded body of file, or a listing of dir when the
+// requested path is a directory. Only one of them is set. An explicit --output
+// or --template renders the metadata instead, while the output format of the
+// profile doesn't, and --raw writes the file bytes untouched.
+func renderFileOrDir(c *cli.Context, file *github.RepositoryContent, dir []*github.RepositoryContent) {
+	if c.Bool("raw") {
+		if file == nil {
+			exit(errors.New("--raw only applies to files"))
+		}
+		body, err := file.Decode()
+		check(err)
+		_, err = os.Stdout.Write(body)
+		check(err)
+		return
+	}
+
+	if c.GlobalIsSet("output") || c.GlobalString("template") != "" {
+		if file != nil {
+			render(c, "RepositoryContent", file)
+		} else {
+			render(c, "RepositoryContent", dir)
+		}
+		return
+	}
+
+	if file == nil {
+		check(renderTable(os.Stdout, "RepositoryContent", dir, nil))
+		return
+	}
+
+	body, err := file.Decode()
+	check(err)
+	fmt.Print(string(body))
+	if !strings.HasSuffix(string(body), "\n") {
+		fmt.Println()
+	}
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// debugTransport traces requests on w. With verbose, it logs the request line,
+// the status, the rate limit and the time taken. With bodies, it also logs the
+// headers and bodies, with credentials redacted. Response bodies are left out
+// when streaming, since they would have to be read in memory first.
+type debugTransport struct {
+	base      http.RoundTripper
+	verbose   bool
+	bodies    bool
+	streaming bool
+	w         io.Writer
+}
+
+func (t *debugTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !t.verbose && !t.bodies {
+		return t.base.RoundTrip(req)
+	}
+
+	fmt.Fprintf(t.w, "> %s %s\n", req.Method, req.URL)
+	if t.bodies {
+		body, err := readBody(req)
+		if err != nil {
+			return nil, err
+		}
+		req = withBody(req, body)
+		printHeaders(t.w, "> ", req.Header)
+		if len(body) > 0 {
+			fmt.Fprintf(t.w, "%s\n", formatBody(body))
+		}
+	}
+
+	start := time.Now()
+	res, err := t.base.RoundTrip(req)
+	elapsed := time.Since(start) / time.Millisecond * time.Millisecond
+	if err != nil {
+		fmt.Fprintf(t.w, "< %v (%s)\n", err, elapsed)
+		return nil, err
+	}
+
+	fmt.Fprintf(t.w, "< %s (%s)%s\n", res.Status, elapsed, describeRate(res.Header))
+	if t.bodies {
+		printHeaders(t.w, "< ", res.Header)
+	}
+	if t.bodies && !t.streaming {
+		body, err := ioutil.ReadAll(res.Body)
+		res.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		res.Body = ioutil.NopCloser(bytes.NewReader(body))
+		if len(body) > 0 {
+			fmt.Fprintf(t.w, "%s\n", formatBody(body))
+		}
+	}
+
+	return res, nil
+}
+
+func printHeaders(w io.Writer, prefix string, h http.Header) {
+	for _, name := range sortedHeaders(h) {
+		for _, value := range h[name] {
+			fmt.Fprintf(w, "%s%s: %s\n", prefix, name, redactHeader(name, value))
+		}
+	}
+}
+
+// describeRate summarizes the rate limit headers, if any
+func describeRate(h http.Header) string {
+	remaining, limit := h.Get("X-RateLimit-Remaining"), h.Get("X-RateLimit-Limit")
+	if remaining == "" || limit == "" {
+		return ""
+	}
+
+	reset, err := strconv.ParseInt(h.Get("X-RateLimit-Reset"), 10, 64)
+	if err != nil {
+		return fmt.Sprintf(", rate limit %s/%s", remaining, limit)
+	}
+	return fmt.Sprintf(", rate limit %s/%s, resets at %s", remaining, limit, time.Unix(reset, 0).Format("15:04:05"))
+}
@@ -0,0 +1,131 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// deviceFlow obtains a token through the OAuth device authorization grant: the
+// user enters a code on the website while the CLI polls for the token, waiting
+// with sleep between requests.
+type deviceFlow struct {
+	client   *http.Client
+	webURL   *url.URL
+	clientID string
+	scopes   string
+	sleep    func(time.Duration)
+}
+
+type deviceCode struct {
+	DeviceCode      string `json:"device_code"`
+	UserCode        string `json:"user_code"`
+	VerificationURI string `json:"verification_uri"`
+	ExpiresIn       int    `json:"expires_in"`
+	Interval        int    `json:"interval"`
+}
+
+type deviceToken struct {
+	AccessToken      string `json:"access_token"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+	Interval         int    `json:"interval"`
+}
+
+// token asks for a code, tells the user where to enter it on w, and waits
+// until they do.
+func (f *deviceFlow) token(w io.Writer) (string, error) {
+	var code deviceCode
+	err := f.post("login/device/code", url.Values{
+		"client_id": {f.clientID},
+		"scope":     {f.scopes},
+	}, &code)
+	if err != nil {
+		return "", err
+	}
+
+	fmt.Fprintf(w, "Open %s and enter the code %s\n", code.VerificationURI, code.UserCode)
+
+	interval := time.Duration(code.Interval) * time.Second
+	if interval <= 0 {
+		interval = 5 * time.Second
+	}
+	deadline := time.Now().Add(time.Duration(code.ExpiresIn) * time.Second)
+
+	for time.Now().Before(deadline) {
+		f.sleep(interval)
+
+		var token deviceToken
+		err := f.post("login/oauth/access_token", url.Values{
+			"client_id":   {f.clientID},
+			"device_code": {code.DeviceCode},
+			"grant_type":  {"urn:ietf:params:oauth:grant-type:device_code"},
+		}, &token)
+		if err != nil {
+			return "", err
+		}
+
+		switch token.Error {
+		case "":
+			return token.AccessToken, nil
+		case "authorization_pending":
+		case "slow_down":
+			// The new interval is given, otherwise the spec says to add 5s
+			if token.Interval > 0 {
+				interval = time.Duration(token.Interval) * time.Second
+			} else {
+				interval += 5 * time.Second
+			}
+		default:
+			if token.ErrorDescription != "" {
+				return "", fmt.Errorf("%s: %s", token.Error, token.ErrorDescription)
+			}
+			return "", fmt.Errorf("%s", token.Error)
+		}
+	}
+
+	return "", fmt.Errorf("The code expired before it was entered")
+}
+
+func (f *deviceFlow) post(path string, form url.Values, v interface{}) error {
+	u, err := f.webURL.Parse(path)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("POST", u.String(), strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	res, err := f.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("POST %s: %s", u, res.Status)
+	}
+	return json.NewDecoder(res.Body).Decode(v)
+}
+
+// webURL is the root of the website hosting the OAuth endpoints for an API
+// URL: github.com for api.github.com, and the appliance for Enterprise.
+func webURL(api *url.URL) *url.URL {
+	u := *api
+	if u.Host == "api.github.com" {
+		u.Host = "github.com"
+	}
+	u.Path = strings.TrimSuffix(u.Path, strings.TrimPrefix(enterpriseAPIPath, "/"))
+	if !strings.HasSuffix(u.Path, "/") {
+		u.Path += "/"
+	}
+	return &u
+}
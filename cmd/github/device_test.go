@@ -0,0 +1,116 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// fakeDeviceServer answers the code request, then the token polls with the
+// given responses in order.
+func fakeDeviceServer(t *testing.T, polls []deviceToken) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Error(err)
+			return
+		}
+		if r.Form.Get("client_id") != "client" {
+			t.Errorf("client_id = %q, want client", r.Form.Get("client_id"))
+		}
+
+		switch r.URL.Path {
+		case "/login/device/code":
+			json.NewEncoder(w).Encode(deviceCode{
+				DeviceCode:      "device",
+				UserCode:        "ABCD-1234",
+				VerificationURI: "https://github.com/login/device",
+				ExpiresIn:       900,
+				Interval:        5,
+			})
+		case "/login/oauth/access_token":
+			if r.Form.Get("device_code") != "device" {
+				t.Errorf("device_code = %q, want device", r.Form.Get("device_code"))
+			}
+			if len(polls) == 0 {
+				t.Error("polled after the last response")
+				http.Error(w, "no more responses", http.StatusInternalServerError)
+				return
+			}
+			json.NewEncoder(w).Encode(polls[0])
+			polls = polls[1:]
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+}
+
+func TestDeviceFlow(t *testing.T) {
+	tests := []struct {
+		name   string
+		polls  []deviceToken
+		token  string
+		err    string
+		sleeps []time.Duration
+	}{
+		{
+			name: "authorized",
+			polls: []deviceToken{
+				{Error: "authorization_pending"},
+				{Error: "slow_down"},
+				{Error: "slow_down", Interval: 20},
+				{AccessToken: "token"},
+			},
+			token:  "token",
+			sleeps: []time.Duration{5 * time.Second, 5 * time.Second, 10 * time.Second, 20 * time.Second},
+		},
+		{
+			name:   "expired",
+			polls:  []deviceToken{{Error: "authorization_pending"}, {Error: "expired_token"}},
+			err:    "expired_token",
+			sleeps: []time.Duration{5 * time.Second, 5 * time.Second},
+		},
+		{
+			name:   "denied",
+			polls:  []deviceToken{{Error: "access_denied", ErrorDescription: "The user has denied your application access."}},
+			err:    "access_denied: The user has denied your application access.",
+			sleeps: []time.Duration{5 * time.Second},
+		},
+	}
+
+	for _, test := range tests {
+		srv := fakeDeviceServer(t, test.polls)
+		webURL, _ := url.Parse(srv.URL + "/")
+
+		var sleeps []time.Duration
+		flow := &deviceFlow{
+			client:   http.DefaultClient,
+			webURL:   webURL,
+			clientID: "client",
+			scopes:   "repo",
+			sleep:    func(d time.Duration) { sleeps = append(sleeps, d) },
+		}
+
+		var out bytes.Buffer
+		token, err := flow.token(&out)
+		srv.Close()
+
+		if token != test.token {
+			t.Errorf("%s: token = %q, want %q", test.name, token, test.token)
+		}
+		if (err == nil) != (test.err == "") || (err != nil && err.Error() != test.err) {
+			t.Errorf("%s: err = %v, want %q", test.name, err, test.err)
+		}
+		if !reflect.DeepEqual(sleeps, test.sleeps) {
+			t.Errorf("%s: slept %v, want %v", test.name, sleeps, test.sleeps)
+		}
+		if !strings.Contains(out.String(), "ABCD-1234") {
+			t.Errorf("%s: user code not shown in %q", test.name, out.String())
+		}
+	}
+}
@@ -0,0 +1,153 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/codegangsta/cli"
+)
+
+// download streams body to the file given with --out, or to stdout, and closes
+// it. Progress is reported on stderr when it is a terminal.
+func download(c *cli.Context, body io.ReadCloser) {
+	check(writeDownload(c.String("out"), body))
+}
+
+// writeDownload copies body to the file name, or to stdout when name is empty
+// or -. A partial file is removed when the copy fails.
+func writeDownload(name string, body io.ReadCloser) (err error) {
+	defer body.Close()
+
+	var out io.Writer = os.Stdout
+	if name != "" && name != "-" {
+		var f *os.File
+		f, err = os.Create(name)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if closeErr := f.Close(); err == nil {
+				err = closeErr
+			}
+			if err != nil {
+				os.Remove(name)
+			}
+		}()
+		out = f
+	}
+
+	if isTerminal(os.Stderr) {
+		p := &progress{}
+		defer p.done()
+		out = io.MultiWriter(out, p)
+	}
+
+	_, err = io.Copy(out, body)
+	return err
+}
+
+// stream readies the client for a request whose response body is streamed: the
+// body of a download can take longer to read than --timeout, which then only
+// bounds the wait for the response headers, and --debug doesn't read it in
+// memory to log it.
+func (a *application) stream() {
+	a.debug.streaming = true
+	if a.http.Timeout > 0 {
+		a.http.Transport = &headerTimeoutTransport{base: a.http.Transport, timeout: a.http.Timeout}
+		a.http.Timeout = 0
+	}
+}
+
+// headerTimeoutTransport cancels requests whose response headers don't arrive
+// within timeout, retries included. Unlike http.Client.Timeout, it lets the
+// body take as long as it needs.
+type headerTimeoutTransport struct {
+	base    http.RoundTripper
+	timeout time.Duration
+}
+
+func (t *headerTimeoutTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx, cancel := context.WithCancel(req.Context())
+	timer := time.AfterFunc(t.timeout, cancel)
+	res, err := t.base.RoundTrip(req.WithContext(ctx))
+	if err != nil || !timer.Stop() {
+		cancel()
+		if err == nil {
+			res.Body.Close()
+			err = context.DeadlineExceeded
+		}
+		return nil, err
+	}
+	res.Body = &cancelBody{ReadCloser: res.Body, cancel: cancel}
+	return res, nil
+}
+
+// cancelBody releases the context of its request once closed
+type cancelBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
+func isTerminal(f *os.File) bool {
+	fi, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return fi.Mode()&os.ModeCharDevice != 0
+}
+
+// progress counts the bytes written through it and redraws a status line on
+// stderr at most every 100ms.
+type progress struct {
+	total   int64
+	started time.Time
+	last    time.Time
+}
+
+func (p *progress) Write(b []byte) (int, error) {
+	if p.started.IsZero() {
+		p.started = time.Now()
+	}
+	p.total += int64(len(b))
+	if time.Since(p.last) > 100*time.Millisecond {
+		p.last = time.Now()
+		p.print()
+	}
+	return len(b), nil
+}
+
+func (p *progress) print() {
+	elapsed := time.Since(p.started).Seconds()
+	if elapsed <= 0 {
+		elapsed = 1
+	}
+	fmt.Fprintf(os.Stderr, "\r%s downloaded (%s/s)   ", humanBytes(p.total), humanBytes(int64(float64(p.total)/elapsed)))
+}
+
+func (p *progress) done() {
+	p.print()
+	fmt.Fprintln(os.Stderr)
+}
+
+func humanBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// failingBody returns its data, then err
+type failingBody struct {
+	io.Reader
+	err error
+}
+
+func (b *failingBody) Read(p []byte) (int, error) {
+	n, err := b.Reader.Read(p)
+	if err == io.EOF {
+		err = b.err
+	}
+	return n, err
+}
+
+func (b *failingBody) Close() error { return nil }
+
+func TestWriteDownload(t *testing.T) {
+	dir, err := ioutil.TempDir("", "download")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	name := filepath.Join(dir, "complete")
+	if err := writeDownload(name, ioutil.NopCloser(strings.NewReader("content"))); err != nil {
+		t.Fatal(err)
+	}
+	if data, _ := ioutil.ReadFile(name); string(data) != "content" {
+		t.Errorf("got %q, want %q", data, "content")
+	}
+
+	name = filepath.Join(dir, "partial")
+	failure := errors.New("connection reset")
+	if err := writeDownload(name, &failingBody{strings.NewReader("cont"), failure}); err != failure {
+		t.Errorf("got error %v, want %v", err, failure)
+	}
+	if _, err := os.Stat(name); !os.IsNotExist(err) {
+		t.Errorf("partial file left behind: %v", err)
+	}
+}
+
+func TestHeaderTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/slow-headers" {
+			time.Sleep(200 * time.Millisecond)
+		}
+		w.Write([]byte("first "))
+		w.(http.Flusher).Flush()
+		time.Sleep(200 * time.Millisecond)
+		w.Write([]byte("second"))
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: &headerTimeoutTransport{base: http.DefaultTransport, timeout: 100 * time.Millisecond}}
+
+	res, err := client.Get(server.URL + "/slow-body")
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, err := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil || string(body) != "first second" {
+		t.Errorf("slow body: got %q, %v, want %q", body, err, "first second")
+	}
+
+	if _, err := client.Get(server.URL + "/slow-headers"); err == nil {
+		t.Error("slow headers: got no error")
+	}
+}
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// dryRunTransport prints requests instead of sending them, and answers with an
+// empty 200 response, which go-github decodes as a zero result.
+type dryRunTransport struct {
+	base    http.RoundTripper
+	enabled bool
+	curl    bool
+	w       io.Writer
+}
+
+func (t *dryRunTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !t.enabled {
+		return t.base.RoundTrip(req)
+	}
+
+	body, err := readBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if t.curl {
+		printCurl(t.w, req, body)
+	} else {
+		printRequest(t.w, req, body)
+	}
+
+	return &http.Response{
+		Status:     "200 OK",
+		StatusCode: http.StatusOK,
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header:     http.Header{"X-Dry-Run": {"true"}},
+		Body:       ioutil.NopCloser(bytes.NewReader(nil)),
+		Request:    req,
+	}, nil
+}
+
+func printRequest(w io.Writer, req *http.Request, body []byte) {
+	fmt.Fprintf(w, "%s %s\n", req.Method, req.URL)
+	for _, name := range sortedHeaders(req.Header) {
+		for _, value := range req.Header[name] {
+			fmt.Fprintf(w, "%s: %s\n", name, redactHeader(name, value))
+		}
+	}
+	if len(body) > 0 {
+		fmt.Fprintf(w, "\n%s\n", formatBody(body))
+	}
+	fmt.Fprintln(w)
+}
+
+func printCurl(w io.Writer, req *http.Request, body []byte) {
+	fmt.Fprintf(w, "curl -X %s %s", req.Method, shellQuote(req.URL.String()))
+	for _, name := range sortedHeaders(req.Header) {
+		for _, value := range req.Header[name] {
+			fmt.Fprintf(w, " \\\n  -H %s", shellQuote(name+": "+redactHeader(name, value)))
+		}
+	}
+	if len(body) > 0 {
+		fmt.Fprintf(w, " \\\n  --data-binary %s", shellQuote(string(body)))
+	}
+	fmt.Fprintln(w)
+}
+
+func sortedHeaders(h http.Header) []string {
+	var names []string
+	for name := range h {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// redactHeader hides credentials, keeping the scheme so that it's still clear
+// how the request is authenticated
+func redactHeader(name, value string) string {
+	if !strings.EqualFold(name, "Authorization") {
+		return value
+	}
+	if i := strings.Index(value, " "); i >= 0 {
+		return value[:i] + " REDACTED"
+	}
+	return "REDACTED"
+}
+
+// formatBody indents JSON bodies, and only gives the size of uploads
+func formatBody(body []byte) string {
+	var out bytes.Buffer
+	if err := json.Indent(&out, body, "", "  "); err != nil {
+		return fmt.Sprintf("<%s of data>", humanBytes(int64(len(body))))
+	}
+	return out.String()
+}
+
+// shellQuote quotes s for a POSIX shell
+func shellQuote(s string) string {
+	return "'" + strings.Replace(s, "'", `'"'"'`, -1) + "'"
+}
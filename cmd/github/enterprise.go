@@ -0,0 +1,51 @@
+package main
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// GitHub Enterprise serves the API under these paths of the appliance
+const (
+	enterpriseAPIPath    = "/api/v3/"
+	enterpriseUploadPath = "/api/uploads/"
+)
+
+// enterpriseURL parses the root URL of an API given with --api-url or
+// --upload-url. A bare host gets the Enterprise path for the API appended, as
+// in https://github.example.com, while any other path is kept, so that
+// https://api.github.com/ and test servers work too. go-github resolves paths
+// relative to the URL, which thus needs a trailing slash.
+func enterpriseURL(raw, path string) (*url.URL, error) {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return nil, err
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("Invalid API URL %q, expected http(s)://host[/path]", raw)
+	}
+
+	switch {
+	case u.Path == "" || u.Path == "/":
+		if u.Host != "api.github.com" && u.Host != "uploads.github.com" {
+			u.Path = path
+		} else {
+			u.Path = "/"
+		}
+	case !strings.HasSuffix(u.Path, "/"):
+		u.Path += "/"
+	}
+	return u, nil
+}
+
+// uploadURL guesses the upload URL of an Enterprise appliance from its API
+// URL, when only the latter is given.
+func uploadURL(api *url.URL) *url.URL {
+	if !strings.HasSuffix(api.Path, enterpriseAPIPath) {
+		return nil
+	}
+	u := *api
+	u.Path = strings.TrimSuffix(u.Path, enterpriseAPIPath) + enterpriseUploadPath
+	return &u
+}
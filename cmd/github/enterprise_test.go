@@ -0,0 +1,57 @@
+package main
+
+import "testing"
+
+func TestEnterpriseURL(t *testing.T) {
+	tests := []struct {
+		raw  string
+		path string
+		want string
+	}{
+		{"https://github.example.com", enterpriseAPIPath, "https://github.example.com/api/v3/"},
+		{"https://github.example.com/", enterpriseAPIPath, "https://github.example.com/api/v3/"},
+		{"https://github.example.com", enterpriseUploadPath, "https://github.example.com/api/uploads/"},
+		{"https://github.example.com/api/v3", enterpriseAPIPath, "https://github.example.com/api/v3/"},
+		{"https://api.github.com", enterpriseAPIPath, "https://api.github.com/"},
+		{"https://uploads.github.com", enterpriseUploadPath, "https://uploads.github.com/"},
+		{"http://127.0.0.1:8080/test/", enterpriseAPIPath, "http://127.0.0.1:8080/test/"},
+	}
+
+	for _, test := range tests {
+		u, err := enterpriseURL(test.raw, test.path)
+		if err != nil {
+			t.Errorf("enterpriseURL(%q): %v", test.raw, err)
+			continue
+		}
+		if u.String() != test.want {
+			t.Errorf("enterpriseURL(%q, %q) = %s, want %s", test.raw, test.path, u, test.want)
+		}
+	}
+
+	for _, raw := range []string{"github.example.com", "ftp://github.example.com", "https://", "%zz"} {
+		if u, err := enterpriseURL(raw, enterpriseAPIPath); err == nil {
+			t.Errorf("enterpriseURL(%q) = %s, want an error", raw, u)
+		}
+	}
+}
+
+func TestUploadURL(t *testing.T) {
+	tests := []struct {
+		api  string
+		want string
+	}{
+		{"https://github.example.com/api/v3/", "https://github.example.com/api/uploads/"},
+		{"https://api.github.com/", ""},
+	}
+
+	for _, test := range tests {
+		api, _ := enterpriseURL(test.api, enterpriseAPIPath)
+		var got string
+		if u := uploadURL(api); u != nil {
+			got = u.String()
+		}
+		if got != test.want {
+			t.Errorf("uploadURL(%s) = %q, want %q", test.api, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,140 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+
+	"github.com/google/go-github/github"
+)
+
+// Exit codes, so that scripts can tell failures apart. They are listed in the
+// README.
+const (
+	exitError        = 1 // Any other failure, including bad usage
+	exitNetwork      = 2 // The API couldn't be reached
+	exitUnauthorized = 3 // 401, the credentials are missing or invalid
+	exitForbidden    = 4 // 403, the credentials lack the permission
+	exitNotFound     = 5 // 404, which GitHub also returns for private resources
+	exitRateLimited  = 6 // The rate limit is exhausted, or abuse detection kicked in
+	exitInvalid      = 7 // 422 and other errors reported by the API
+	exitAccepted     = 8 // 202, the result is being computed, try again later
+)
+
+// cliError is an error classified for reporting, from which the exit code
+// follows.
+type cliError struct {
+	Code    int            `json:"code"`
+	Status  int            `json:"status,omitempty"`
+	Message string         `json:"message"`
+	Errors  []github.Error `json:"errors,omitempty"`
+}
+
+func (e *cliError) Error() string {
+	var msg bytes.Buffer
+	msg.WriteString(e.Message)
+	for _, fieldErr := range e.Errors {
+		msg.WriteString("\n  " + describeFieldError(fieldErr))
+	}
+	return msg.String()
+}
+
+// describeFieldError spells out the codes documented at
+// https://developer.github.com/v3/#client-errors
+func describeFieldError(e github.Error) string {
+	field := e.Field
+	if e.Resource != "" {
+		field = e.Resource + "." + e.Field
+	}
+
+	switch e.Code {
+	case "missing":
+		return field + ": does not exist"
+	case "missing_field":
+		return field + ": is required"
+	case "invalid":
+		return field + ": is invalid"
+	case "already_exists":
+		return field + ": already exists"
+	default:
+		return field + ": " + e.Code
+	}
+}
+
+// classify maps the errors returned by go-github to a cliError.
+func classify(err error) *cliError {
+	switch e := err.(type) {
+	case *cliError:
+		return e
+	case *github.RateLimitError:
+		return &cliError{
+			Code:    exitRateLimited,
+			Status:  e.Response.StatusCode,
+			Message: fmt.Sprintf("%s, resets at %s", e.Message, e.Rate.Reset.Format("15:04:05")),
+		}
+	case *github.ErrorResponse:
+		return &cliError{
+			Code:    statusCode(e.Response.StatusCode),
+			Status:  e.Response.StatusCode,
+			Message: fmt.Sprintf("%s %s: %d %s", e.Response.Request.Method, e.Response.Request.URL, e.Response.StatusCode, e.Message),
+			Errors:  e.Errors,
+		}
+	case *url.Error:
+		// http.Client also wraps the errors of the token source, such as a
+		// rejected GitHub App token exchange, which aren't network failures
+		switch e.Err.(type) {
+		case *cliError, *github.ErrorResponse, *os.PathError:
+			return classify(e.Err)
+		}
+		return &cliError{Code: exitNetwork, Message: err.Error()}
+	case net.Error:
+		return &cliError{Code: exitNetwork, Message: err.Error()}
+	default:
+		return &cliError{Code: exitError, Message: err.Error()}
+	}
+}
+
+func statusCode(status int) int {
+	switch status {
+	case http.StatusAccepted:
+		return exitAccepted
+	case http.StatusUnauthorized:
+		return exitUnauthorized
+	case http.StatusForbidden:
+		return exitForbidden
+	case http.StatusNotFound:
+		return exitNotFound
+	case 429:
+		return exitRateLimited
+	default:
+		return exitInvalid
+	}
+}
+
+// checkStatus is github.CheckResponse, except that a 202 to a GET is an error
+// too: GitHub answers it when the result, such as repository statistics, is
+// still being computed.
+func checkStatus(res *http.Response) error {
+	if res.StatusCode == http.StatusAccepted && res.Request != nil && res.Request.Method == "GET" {
+		return &github.ErrorResponse{Response: res, Message: "The result is being computed by GitHub, try again in a moment"}
+	}
+	return github.CheckResponse(res)
+}
+
+// exit reports err on stderr, as JSON when that's the output format, and exits
+// with the matching code.
+func exit(err error) {
+	e := classify(err)
+
+	if app.profile.Output == "json" {
+		out, _ := json.MarshalIndent(map[string]*cliError{"error": e}, "", "  ")
+		fmt.Fprintln(os.Stderr, string(out))
+	} else {
+		fmt.Fprintln(os.Stderr, e)
+	}
+	os.Exit(e.Code)
+}
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-github/github"
+)
+
+func TestClassify(t *testing.T) {
+	req, _ := http.NewRequest("GET", "https://api.github.com/repos/o/r", nil)
+	response := func(status int) *http.Response {
+		return &http.Response{StatusCode: status, Status: http.StatusText(status), Request: req}
+	}
+
+	tests := []struct {
+		name string
+		err  error
+		code int
+	}{
+		{"other", errors.New("boom"), exitError},
+		{"network", &url.Error{Op: "Get", URL: req.URL.String(), Err: &net.OpError{Op: "dial", Err: errors.New("connection refused")}}, exitNetwork},
+		{"transport", &url.Error{Op: "Get", URL: req.URL.String(), Err: errors.New("EOF")}, exitNetwork},
+		{"token source", &url.Error{Op: "Get", URL: req.URL.String(), Err: &cliError{Code: exitUnauthorized, Message: "Could not get an installation token"}}, exitUnauthorized},
+		{"not found", &github.ErrorResponse{Response: response(404), Message: "Not Found"}, exitNotFound},
+		{"forbidden", &github.ErrorResponse{Response: response(403), Message: "Forbidden"}, exitForbidden},
+		{"invalid", &github.ErrorResponse{Response: response(422), Message: "Validation Failed"}, exitInvalid},
+		{"accepted", checkStatus(response(202)), exitAccepted},
+	}
+
+	for _, test := range tests {
+		if code := classify(test.err).Code; code != test.code {
+			t.Errorf("%s: code = %d, want %d", test.name, code, test.code)
+		}
+	}
+
+	if err := checkStatus(response(200)); err != nil {
+		t.Errorf("checkStatus(200) = %v, want nil", err)
+	}
+}
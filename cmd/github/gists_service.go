@@ -1,13 +1,10 @@
 package main
 
 import (
-	"fmt"
 	"strconv"
 
 	"github.com/codegangsta/cli"
 	"github.com/google/go-github/github"
-	"github.com/jinzhu/now"
-	"github.com/kr/pretty"
 )
 
 var GistsService = cli.Command{
@@ -25,35 +22,41 @@ var GistsService = cli.Command{
 
    GitHub API docs: http://developer.github.com/v3/gists/#list-gists`,
 			Flags: []cli.Flag{
-				cli.StringFlag{Name: `since`, Usage: `Since filters Gists by time.`},
+				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
+				cli.IntFlag{Name: `limit`, Usage: `Stop after this many items, fetching the following pages as needed`},
 				cli.IntFlag{Name: `page`, Usage: `For paginated result sets, page of results to retrieve.`},
 				cli.IntFlag{Name: `per-page`, Usage: `For paginated result sets, the number of results to include per page.`},
-				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
+				cli.StringFlag{Name: `since`, Usage: `Since filters Gists by time.`},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the GistListOptions, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 1 {
+				args := c.Args()
+				if len(args) < 1 {
 					showHelp(c, "list", "list <user>")
 				}
 
-				user := c.Args().Get(0)
-				opt := &github.GistListOptions{
-					Since: now.MustParse(c.String("since")),
+				user := args.Get(0)
+				opt := &github.GistListOptions{}
+				check(decodeFile(c.String("body-file"), opt))
+				if c.IsSet("since") {
+					opt.Since = timeFlag(c, "since")
+				}
+				if c.IsSet("page") {
+					opt.Page = c.Int("page")
+				}
+				if c.IsSet("per-page") {
+					opt.PerPage = c.Int("per-page")
 				}
 
-				var items []github.Gist
-
-				for {
-					page, res, err := app.gh.Gists.List(user, opt)
-					checkResponse(res.Response, err)
-
-					items = append(items, page...)
-					if res.NextPage == 0 || !c.Bool("all") {
-						break
-					}
-					opt.Page = res.NextPage
+				if opt.PerPage == 0 {
+					opt.PerPage = app.profile.PerPage
 				}
 
-				fmt.Printf("%# v", pretty.Formatter(items))
+				paginate(c, "Gist", func(page int) (interface{}, *github.Response, error) {
+					opt := *opt
+					opt.Page = page
+					return app.gh.Gists.List(user, &opt)
+				})
 			},
 		}, cli.Command{
 			Name:  "list-all",
@@ -62,30 +65,35 @@ var GistsService = cli.Command{
 
    GitHub API docs: http://developer.github.com/v3/gists/#list-gists`,
 			Flags: []cli.Flag{
+				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
+				cli.IntFlag{Name: `limit`, Usage: `Stop after this many items, fetching the following pages as needed`},
 				cli.IntFlag{Name: `page`, Usage: `For paginated result sets, page of results to retrieve.`},
 				cli.IntFlag{Name: `per-page`, Usage: `For paginated result sets, the number of results to include per page.`},
-				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
 				cli.StringFlag{Name: `since`, Usage: `Since filters Gists by time.`},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the GistListOptions, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				opt := &github.GistListOptions{
-					Since: now.MustParse(c.String("since")),
+				opt := &github.GistListOptions{}
+				check(decodeFile(c.String("body-file"), opt))
+				if c.IsSet("since") {
+					opt.Since = timeFlag(c, "since")
+				}
+				if c.IsSet("page") {
+					opt.Page = c.Int("page")
+				}
+				if c.IsSet("per-page") {
+					opt.PerPage = c.Int("per-page")
 				}
 
-				var items []github.Gist
-
-				for {
-					page, res, err := app.gh.Gists.ListAll(opt)
-					checkResponse(res.Response, err)
-
-					items = append(items, page...)
-					if res.NextPage == 0 || !c.Bool("all") {
-						break
-					}
-					opt.Page = res.NextPage
+				if opt.PerPage == 0 {
+					opt.PerPage = app.profile.PerPage
 				}
 
-				fmt.Printf("%# v", pretty.Formatter(items))
+				paginate(c, "Gist", func(page int) (interface{}, *github.Response, error) {
+					opt := *opt
+					opt.Page = page
+					return app.gh.Gists.ListAll(&opt)
+				})
 			},
 		}, cli.Command{
 			Name:  "list-starred",
@@ -94,30 +102,35 @@ var GistsService = cli.Command{
 
    GitHub API docs: http://developer.github.com/v3/gists/#list-gists`,
 			Flags: []cli.Flag{
-				cli.StringFlag{Name: `since`, Usage: `Since filters Gists by time.`},
+				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
+				cli.IntFlag{Name: `limit`, Usage: `Stop after this many items, fetching the following pages as needed`},
 				cli.IntFlag{Name: `page`, Usage: `For paginated result sets, page of results to retrieve.`},
 				cli.IntFlag{Name: `per-page`, Usage: `For paginated result sets, the number of results to include per page.`},
-				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
+				cli.StringFlag{Name: `since`, Usage: `Since filters Gists by time.`},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the GistListOptions, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				opt := &github.GistListOptions{
-					Since: now.MustParse(c.String("since")),
+				opt := &github.GistListOptions{}
+				check(decodeFile(c.String("body-file"), opt))
+				if c.IsSet("since") {
+					opt.Since = timeFlag(c, "since")
+				}
+				if c.IsSet("page") {
+					opt.Page = c.Int("page")
+				}
+				if c.IsSet("per-page") {
+					opt.PerPage = c.Int("per-page")
 				}
 
-				var items []github.Gist
-
-				for {
-					page, res, err := app.gh.Gists.ListStarred(opt)
-					checkResponse(res.Response, err)
-
-					items = append(items, page...)
-					if res.NextPage == 0 || !c.Bool("all") {
-						break
-					}
-					opt.Page = res.NextPage
+				if opt.PerPage == 0 {
+					opt.PerPage = app.profile.PerPage
 				}
 
-				fmt.Printf("%# v", pretty.Formatter(items))
+				paginate(c, "Gist", func(page int) (interface{}, *github.Response, error) {
+					opt := *opt
+					opt.Page = page
+					return app.gh.Gists.ListStarred(&opt)
+				})
 			},
 		}, cli.Command{
 			Name:  "get",
@@ -127,15 +140,16 @@ var GistsService = cli.Command{
    GitHub API docs: http://developer.github.com/v3/gists/#get-a-single-gist`,
 			Flags: []cli.Flag{},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 1 {
+				args := c.Args()
+				if len(args) < 1 {
 					showHelp(c, "get", "get <id>")
 				}
 
-				id := c.Args().Get(0)
+				id := args.Get(0)
 
 				result, res, err := app.gh.Gists.Get(id)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "Gist", result)
 
 			},
 		}, cli.Command{
@@ -146,16 +160,17 @@ var GistsService = cli.Command{
    GitHub API docs: https://developer.github.com/v3/gists/#get-a-specific-revision-of-a-gist`,
 			Flags: []cli.Flag{},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 2 {
+				args := c.Args()
+				if len(args) < 2 {
 					showHelp(c, "get-revision", "get-revision <id> <sha>")
 				}
 
-				id := c.Args().Get(0)
-				sha := c.Args().Get(1)
+				id := args.Get(0)
+				sha := args.Get(1)
 
 				result, res, err := app.gh.Gists.GetRevision(id, sha)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "Gist", result)
 
 			},
 		}, cli.Command{
@@ -165,53 +180,66 @@ var GistsService = cli.Command{
 
    GitHub API docs: http://developer.github.com/v3/gists/#create-a-gist`,
 			Flags: []cli.Flag{
-				cli.StringFlag{Name: `id`, Usage: ``},
+				cli.IntFlag{Name: `comments`, Usage: ``},
+				cli.StringFlag{Name: `created-at`, Usage: ``},
 				cli.StringFlag{Name: `description`, Usage: ``},
-				cli.BoolFlag{Name: `public`, Usage: ``},
-				cli.IntFlag{Name: `owner-id`, Usage: ``},
-				cli.IntFlag{Name: `owner-public-repos`, Usage: ``},
-				cli.StringFlag{Name: `owner-updated-at`, Usage: ``},
+				cli.StringFlag{Name: `id`, Usage: ``},
 				cli.StringFlag{Name: `owner-bio`, Usage: ``},
-				cli.BoolFlag{Name: `owner-site-admin`, Usage: ``},
-				cli.StringFlag{Name: `owner-location`, Usage: ``},
-				cli.IntFlag{Name: `owner-disk-usage`, Usage: ``},
-				cli.BoolFlag{Name: `owner-hireable`, Usage: ``},
-				cli.StringFlag{Name: `owner-login`, Usage: ``},
+				cli.StringFlag{Name: `owner-blog`, Usage: ``},
+				cli.IntFlag{Name: `owner-collaborators`, Usage: ``},
 				cli.StringFlag{Name: `owner-company`, Usage: ``},
+				cli.StringFlag{Name: `owner-created-at`, Usage: ``},
+				cli.IntFlag{Name: `owner-disk-usage`, Usage: ``},
 				cli.StringFlag{Name: `owner-email`, Usage: ``},
-				cli.IntFlag{Name: `owner-following`, Usage: ``},
-				cli.StringFlag{Name: `owner-type`, Usage: ``},
-				cli.StringFlag{Name: `owner-name`, Usage: ``},
-				cli.IntFlag{Name: `owner-public-gists`, Usage: ``},
 				cli.IntFlag{Name: `owner-followers`, Usage: ``},
-				cli.IntFlag{Name: `owner-private-gists`, Usage: ``},
+				cli.IntFlag{Name: `owner-following`, Usage: ``},
 				cli.StringFlag{Name: `owner-gravatar-id`, Usage: ``},
-				cli.StringFlag{Name: `owner-blog`, Usage: ``},
-				cli.StringFlag{Name: `owner-created-at`, Usage: ``},
-				cli.IntFlag{Name: `owner-total-private-repos`, Usage: ``},
+				cli.BoolFlag{Name: `owner-hireable`, Usage: ``},
+				cli.IntFlag{Name: `owner-id`, Usage: ``},
+				cli.StringFlag{Name: `owner-location`, Usage: ``},
+				cli.StringFlag{Name: `owner-login`, Usage: ``},
+				cli.StringFlag{Name: `owner-name`, Usage: ``},
 				cli.IntFlag{Name: `owner-owned-private-repos`, Usage: ``},
-				cli.IntFlag{Name: `owner-collaborators`, Usage: ``},
-				cli.StringFlag{Name: `owner-plan-name`, Usage: ``},
-				cli.IntFlag{Name: `owner-plan-space`, Usage: ``},
 				cli.IntFlag{Name: `owner-plan-collaborators`, Usage: ``},
+				cli.StringFlag{Name: `owner-plan-name`, Usage: ``},
 				cli.IntFlag{Name: `owner-plan-private-repos`, Usage: ``},
-				cli.IntFlag{Name: `comments`, Usage: ``},
-				cli.StringFlag{Name: `created-at`, Usage: ``},
+				cli.IntFlag{Name: `owner-plan-space`, Usage: ``},
+				cli.IntFlag{Name: `owner-private-gists`, Usage: ``},
+				cli.IntFlag{Name: `owner-public-gists`, Usage: ``},
+				cli.IntFlag{Name: `owner-public-repos`, Usage: ``},
+				cli.BoolFlag{Name: `owner-site-admin`, Usage: ``},
+				cli.IntFlag{Name: `owner-total-private-repos`, Usage: ``},
+				cli.StringFlag{Name: `owner-type`, Usage: ``},
+				cli.StringFlag{Name: `owner-updated-at`, Usage: ``},
+				cli.BoolFlag{Name: `public`, Usage: ``},
 				cli.StringFlag{Name: `updated-at`, Usage: ``},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the Gist, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				gist := &github.Gist{
-					Comments:    github.Int(c.Int("comments")),
-					CreatedAt:   timePointer(now.MustParse(c.String("created-at"))),
-					UpdatedAt:   timePointer(now.MustParse(c.String("updated-at"))),
-					ID:          github.String(c.String("id")),
-					Description: github.String(c.String("description")),
-					Public:      github.Bool(c.Bool("public")),
+				gist := &github.Gist{}
+				check(decodeFile(c.String("body-file"), gist))
+				if c.IsSet("comments") {
+					gist.Comments = github.Int(c.Int("comments"))
+				}
+				if c.IsSet("created-at") {
+					gist.CreatedAt = timePointer(timeFlag(c, "created-at"))
+				}
+				if c.IsSet("description") {
+					gist.Description = github.String(c.String("description"))
+				}
+				if c.IsSet("id") {
+					gist.ID = github.String(c.String("id"))
+				}
+				if c.IsSet("public") {
+					gist.Public = github.Bool(c.Bool("public"))
+				}
+				if c.IsSet("updated-at") {
+					gist.UpdatedAt = timePointer(timeFlag(c, "updated-at"))
 				}
 
 				result, res, err := app.gh.Gists.Create(gist)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "Gist", result)
 
 			},
 		}, cli.Command{
@@ -221,58 +249,72 @@ var GistsService = cli.Command{
 
    GitHub API docs: http://developer.github.com/v3/gists/#edit-a-gist`,
 			Flags: []cli.Flag{
-				cli.StringFlag{Name: `id`, Usage: ``},
+				cli.IntFlag{Name: `comments`, Usage: ``},
+				cli.StringFlag{Name: `created-at`, Usage: ``},
 				cli.StringFlag{Name: `description`, Usage: ``},
-				cli.BoolFlag{Name: `public`, Usage: ``},
-				cli.BoolFlag{Name: `owner-hireable`, Usage: ``},
+				cli.StringFlag{Name: `id`, Usage: ``},
+				cli.StringFlag{Name: `owner-bio`, Usage: ``},
+				cli.StringFlag{Name: `owner-blog`, Usage: ``},
+				cli.IntFlag{Name: `owner-collaborators`, Usage: ``},
+				cli.StringFlag{Name: `owner-company`, Usage: ``},
+				cli.StringFlag{Name: `owner-created-at`, Usage: ``},
+				cli.IntFlag{Name: `owner-disk-usage`, Usage: ``},
 				cli.StringFlag{Name: `owner-email`, Usage: ``},
+				cli.IntFlag{Name: `owner-followers`, Usage: ``},
 				cli.IntFlag{Name: `owner-following`, Usage: ``},
-				cli.StringFlag{Name: `owner-type`, Usage: ``},
+				cli.StringFlag{Name: `owner-gravatar-id`, Usage: ``},
+				cli.BoolFlag{Name: `owner-hireable`, Usage: ``},
+				cli.IntFlag{Name: `owner-id`, Usage: ``},
+				cli.StringFlag{Name: `owner-location`, Usage: ``},
 				cli.StringFlag{Name: `owner-login`, Usage: ``},
-				cli.StringFlag{Name: `owner-company`, Usage: ``},
-				cli.IntFlag{Name: `owner-private-gists`, Usage: ``},
 				cli.StringFlag{Name: `owner-name`, Usage: ``},
-				cli.IntFlag{Name: `owner-public-gists`, Usage: ``},
-				cli.IntFlag{Name: `owner-followers`, Usage: ``},
-				cli.IntFlag{Name: `owner-total-private-repos`, Usage: ``},
 				cli.IntFlag{Name: `owner-owned-private-repos`, Usage: ``},
-				cli.IntFlag{Name: `owner-collaborators`, Usage: ``},
-				cli.IntFlag{Name: `owner-plan-space`, Usage: ``},
 				cli.IntFlag{Name: `owner-plan-collaborators`, Usage: ``},
-				cli.IntFlag{Name: `owner-plan-private-repos`, Usage: ``},
 				cli.StringFlag{Name: `owner-plan-name`, Usage: ``},
-				cli.StringFlag{Name: `owner-gravatar-id`, Usage: ``},
-				cli.StringFlag{Name: `owner-blog`, Usage: ``},
-				cli.StringFlag{Name: `owner-created-at`, Usage: ``},
-				cli.IntFlag{Name: `owner-id`, Usage: ``},
+				cli.IntFlag{Name: `owner-plan-private-repos`, Usage: ``},
+				cli.IntFlag{Name: `owner-plan-space`, Usage: ``},
+				cli.IntFlag{Name: `owner-private-gists`, Usage: ``},
+				cli.IntFlag{Name: `owner-public-gists`, Usage: ``},
 				cli.IntFlag{Name: `owner-public-repos`, Usage: ``},
-				cli.StringFlag{Name: `owner-updated-at`, Usage: ``},
-				cli.StringFlag{Name: `owner-bio`, Usage: ``},
 				cli.BoolFlag{Name: `owner-site-admin`, Usage: ``},
-				cli.StringFlag{Name: `owner-location`, Usage: ``},
-				cli.IntFlag{Name: `owner-disk-usage`, Usage: ``},
-				cli.IntFlag{Name: `comments`, Usage: ``},
-				cli.StringFlag{Name: `created-at`, Usage: ``},
+				cli.IntFlag{Name: `owner-total-private-repos`, Usage: ``},
+				cli.StringFlag{Name: `owner-type`, Usage: ``},
+				cli.StringFlag{Name: `owner-updated-at`, Usage: ``},
+				cli.BoolFlag{Name: `public`, Usage: ``},
 				cli.StringFlag{Name: `updated-at`, Usage: ``},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the Gist, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 1 {
+				args := c.Args()
+				if len(args) < 1 {
 					showHelp(c, "edit", "edit <id>")
 				}
 
-				id := c.Args().Get(0)
-				gist := &github.Gist{
-					ID:          github.String(c.String("id")),
-					Description: github.String(c.String("description")),
-					Public:      github.Bool(c.Bool("public")),
-					Comments:    github.Int(c.Int("comments")),
-					CreatedAt:   timePointer(now.MustParse(c.String("created-at"))),
-					UpdatedAt:   timePointer(now.MustParse(c.String("updated-at"))),
+				id := args.Get(0)
+				gist := &github.Gist{}
+				check(decodeFile(c.String("body-file"), gist))
+				if c.IsSet("comments") {
+					gist.Comments = github.Int(c.Int("comments"))
+				}
+				if c.IsSet("created-at") {
+					gist.CreatedAt = timePointer(timeFlag(c, "created-at"))
+				}
+				if c.IsSet("description") {
+					gist.Description = github.String(c.String("description"))
+				}
+				if c.IsSet("id") {
+					gist.ID = github.String(c.String("id"))
+				}
+				if c.IsSet("public") {
+					gist.Public = github.Bool(c.Bool("public"))
+				}
+				if c.IsSet("updated-at") {
+					gist.UpdatedAt = timePointer(timeFlag(c, "updated-at"))
 				}
 
 				result, res, err := app.gh.Gists.Edit(id, gist)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "Gist", result)
 
 			},
 		}, cli.Command{
@@ -281,16 +323,21 @@ var GistsService = cli.Command{
 			Description: `delete a gist.
 
    GitHub API docs: http://developer.github.com/v3/gists/#delete-a-gist`,
-			Flags: []cli.Flag{},
+			Flags: []cli.Flag{
+				cli.BoolFlag{Name: `yes`, Usage: `Don't ask for confirmation`},
+			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 1 {
+				args := c.Args()
+				if len(args) < 1 {
 					showHelp(c, "delete", "delete <id>")
 				}
 
-				id := c.Args().Get(0)
+				id := args.Get(0)
+
+				confirm(c, "delete", id)
 
 				res, err := app.gh.Gists.Delete(id)
-				checkResponse(res.Response, err)
+				checkResponse(res, err)
 
 			},
 		}, cli.Command{
@@ -301,14 +348,15 @@ var GistsService = cli.Command{
    GitHub API docs: http://developer.github.com/v3/gists/#star-a-gist`,
 			Flags: []cli.Flag{},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 1 {
+				args := c.Args()
+				if len(args) < 1 {
 					showHelp(c, "star", "star <id>")
 				}
 
-				id := c.Args().Get(0)
+				id := args.Get(0)
 
 				res, err := app.gh.Gists.Star(id)
-				checkResponse(res.Response, err)
+				checkResponse(res, err)
 
 			},
 		}, cli.Command{
@@ -319,14 +367,15 @@ var GistsService = cli.Command{
    Github API docs: http://developer.github.com/v3/gists/#unstar-a-gist`,
 			Flags: []cli.Flag{},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 1 {
+				args := c.Args()
+				if len(args) < 1 {
 					showHelp(c, "unstar", "unstar <id>")
 				}
 
-				id := c.Args().Get(0)
+				id := args.Get(0)
 
 				res, err := app.gh.Gists.Unstar(id)
-				checkResponse(res.Response, err)
+				checkResponse(res, err)
 
 			},
 		}, cli.Command{
@@ -337,15 +386,16 @@ var GistsService = cli.Command{
    GitHub API docs: http://developer.github.com/v3/gists/#check-if-a-gist-is-starred`,
 			Flags: []cli.Flag{},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 1 {
+				args := c.Args()
+				if len(args) < 1 {
 					showHelp(c, "is-starred", "is-starred <id>")
 				}
 
-				id := c.Args().Get(0)
+				id := args.Get(0)
 
 				result, res, err := app.gh.Gists.IsStarred(id)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "bool", result)
 
 			},
 		}, cli.Command{
@@ -356,15 +406,16 @@ var GistsService = cli.Command{
    GitHub API docs: http://developer.github.com/v3/gists/#fork-a-gist`,
 			Flags: []cli.Flag{},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 1 {
+				args := c.Args()
+				if len(args) < 1 {
 					showHelp(c, "fork", "fork <id>")
 				}
 
-				id := c.Args().Get(0)
+				id := args.Get(0)
 
 				result, res, err := app.gh.Gists.Fork(id)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "Gist", result)
 
 			},
 		}, cli.Command{
@@ -377,32 +428,34 @@ var GistsService = cli.Command{
 				cli.IntFlag{Name: `page`, Usage: `For paginated result sets, page of results to retrieve.`},
 				cli.IntFlag{Name: `per-page`, Usage: `For paginated result sets, the number of results to include per page.`},
 				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
+				cli.IntFlag{Name: `limit`, Usage: `Stop after this many items, fetching the following pages as needed`},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the ListOptions, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 1 {
+				args := c.Args()
+				if len(args) < 1 {
 					showHelp(c, "list-comments", "list-comments <gist-id>")
 				}
 
-				gistID := c.Args().Get(0)
-				opt := &github.ListOptions{
-					Page:    c.Int("page"),
-					PerPage: c.Int("per-page"),
+				gistID := args.Get(0)
+				opt := &github.ListOptions{}
+				check(decodeFile(c.String("body-file"), opt))
+				if c.IsSet("page") {
+					opt.Page = c.Int("page")
+				}
+				if c.IsSet("per-page") {
+					opt.PerPage = c.Int("per-page")
 				}
 
-				var items []github.GistComment
-
-				for {
-					page, res, err := app.gh.Gists.ListComments(gistID, opt)
-					checkResponse(res.Response, err)
-
-					items = append(items, page...)
-					if res.NextPage == 0 || !c.Bool("all") {
-						break
-					}
-					opt.Page = res.NextPage
+				if opt.PerPage == 0 {
+					opt.PerPage = app.profile.PerPage
 				}
 
-				fmt.Printf("%# v", pretty.Formatter(items))
+				paginate(c, "GistComment", func(page int) (interface{}, *github.Response, error) {
+					opt := *opt
+					opt.Page = page
+					return app.gh.Gists.ListComments(gistID, &opt)
+				})
 			},
 		}, cli.Command{
 			Name:  "get-comment",
@@ -412,17 +465,18 @@ var GistsService = cli.Command{
    GitHub API docs: http://developer.github.com/v3/gists/comments/#get-a-single-comment`,
 			Flags: []cli.Flag{},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 2 {
+				args := c.Args()
+				if len(args) < 2 {
 					showHelp(c, "get-comment", "get-comment <gist-id> <comment-id>")
 				}
 
-				gistID := c.Args().Get(0)
-				commentID, err := strconv.Atoi(c.Args().Get(1))
+				gistID := args.Get(0)
+				commentID, err := strconv.Atoi(args.Get(1))
 				check(err)
 
 				result, res, err := app.gh.Gists.GetComment(gistID, commentID)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "GistComment", result)
 
 			},
 		}, cli.Command{
@@ -432,52 +486,60 @@ var GistsService = cli.Command{
 
    GitHub API docs: http://developer.github.com/v3/gists/comments/#create-a-comment`,
 			Flags: []cli.Flag{
-				cli.IntFlag{Name: `id`, Usage: ``},
 				cli.StringFlag{Name: `body`, Usage: ``},
-				cli.StringFlag{Name: `user-login`, Usage: ``},
+				cli.StringFlag{Name: `created-at`, Usage: ``},
+				cli.IntFlag{Name: `id`, Usage: ``},
+				cli.StringFlag{Name: `user-bio`, Usage: ``},
+				cli.StringFlag{Name: `user-blog`, Usage: ``},
+				cli.IntFlag{Name: `user-collaborators`, Usage: ``},
 				cli.StringFlag{Name: `user-company`, Usage: ``},
+				cli.StringFlag{Name: `user-created-at`, Usage: ``},
+				cli.IntFlag{Name: `user-disk-usage`, Usage: ``},
 				cli.StringFlag{Name: `user-email`, Usage: ``},
-				cli.IntFlag{Name: `user-following`, Usage: ``},
-				cli.StringFlag{Name: `user-type`, Usage: ``},
-				cli.StringFlag{Name: `user-name`, Usage: ``},
-				cli.IntFlag{Name: `user-public-gists`, Usage: ``},
 				cli.IntFlag{Name: `user-followers`, Usage: ``},
-				cli.IntFlag{Name: `user-private-gists`, Usage: ``},
+				cli.IntFlag{Name: `user-following`, Usage: ``},
 				cli.StringFlag{Name: `user-gravatar-id`, Usage: ``},
-				cli.StringFlag{Name: `user-blog`, Usage: ``},
-				cli.StringFlag{Name: `user-created-at`, Usage: ``},
-				cli.IntFlag{Name: `user-total-private-repos`, Usage: ``},
+				cli.BoolFlag{Name: `user-hireable`, Usage: ``},
+				cli.IntFlag{Name: `user-id`, Usage: ``},
+				cli.StringFlag{Name: `user-location`, Usage: ``},
+				cli.StringFlag{Name: `user-login`, Usage: ``},
+				cli.StringFlag{Name: `user-name`, Usage: ``},
 				cli.IntFlag{Name: `user-owned-private-repos`, Usage: ``},
-				cli.IntFlag{Name: `user-collaborators`, Usage: ``},
-				cli.StringFlag{Name: `user-plan-name`, Usage: ``},
-				cli.IntFlag{Name: `user-plan-space`, Usage: ``},
 				cli.IntFlag{Name: `user-plan-collaborators`, Usage: ``},
+				cli.StringFlag{Name: `user-plan-name`, Usage: ``},
 				cli.IntFlag{Name: `user-plan-private-repos`, Usage: ``},
-				cli.IntFlag{Name: `user-id`, Usage: ``},
+				cli.IntFlag{Name: `user-plan-space`, Usage: ``},
+				cli.IntFlag{Name: `user-private-gists`, Usage: ``},
+				cli.IntFlag{Name: `user-public-gists`, Usage: ``},
 				cli.IntFlag{Name: `user-public-repos`, Usage: ``},
-				cli.StringFlag{Name: `user-updated-at`, Usage: ``},
-				cli.StringFlag{Name: `user-bio`, Usage: ``},
 				cli.BoolFlag{Name: `user-site-admin`, Usage: ``},
-				cli.StringFlag{Name: `user-location`, Usage: ``},
-				cli.IntFlag{Name: `user-disk-usage`, Usage: ``},
-				cli.BoolFlag{Name: `user-hireable`, Usage: ``},
-				cli.StringFlag{Name: `created-at`, Usage: ``},
+				cli.IntFlag{Name: `user-total-private-repos`, Usage: ``},
+				cli.StringFlag{Name: `user-type`, Usage: ``},
+				cli.StringFlag{Name: `user-updated-at`, Usage: ``},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the GistComment, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 1 {
+				args := c.Args()
+				if len(args) < 1 {
 					showHelp(c, "create-comment", "create-comment <gist-id>")
 				}
 
-				gistID := c.Args().Get(0)
-				comment := &github.GistComment{
-					ID:        github.Int(c.Int("id")),
-					Body:      github.String(c.String("body")),
-					CreatedAt: timePointer(now.MustParse(c.String("created-at"))),
+				gistID := args.Get(0)
+				comment := &github.GistComment{}
+				check(decodeFile(c.String("body-file"), comment))
+				if c.IsSet("body") {
+					comment.Body = github.String(c.String("body"))
+				}
+				if c.IsSet("created-at") {
+					comment.CreatedAt = timePointer(timeFlag(c, "created-at"))
+				}
+				if c.IsSet("id") {
+					comment.ID = github.Int(c.Int("id"))
 				}
 
 				result, res, err := app.gh.Gists.CreateComment(gistID, comment)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "GistComment", result)
 
 			},
 		}, cli.Command{
@@ -487,54 +549,62 @@ var GistsService = cli.Command{
 
    GitHub API docs: http://developer.github.com/v3/gists/comments/#edit-a-comment`,
 			Flags: []cli.Flag{
-				cli.StringFlag{Name: `user-name`, Usage: ``},
-				cli.IntFlag{Name: `user-public-gists`, Usage: ``},
-				cli.IntFlag{Name: `user-followers`, Usage: ``},
-				cli.IntFlag{Name: `user-private-gists`, Usage: ``},
-				cli.StringFlag{Name: `user-gravatar-id`, Usage: ``},
+				cli.StringFlag{Name: `body`, Usage: ``},
+				cli.StringFlag{Name: `created-at`, Usage: ``},
+				cli.IntFlag{Name: `id`, Usage: ``},
+				cli.StringFlag{Name: `user-bio`, Usage: ``},
 				cli.StringFlag{Name: `user-blog`, Usage: ``},
+				cli.IntFlag{Name: `user-collaborators`, Usage: ``},
+				cli.StringFlag{Name: `user-company`, Usage: ``},
 				cli.StringFlag{Name: `user-created-at`, Usage: ``},
-				cli.IntFlag{Name: `user-total-private-repos`, Usage: ``},
+				cli.IntFlag{Name: `user-disk-usage`, Usage: ``},
+				cli.StringFlag{Name: `user-email`, Usage: ``},
+				cli.IntFlag{Name: `user-followers`, Usage: ``},
+				cli.IntFlag{Name: `user-following`, Usage: ``},
+				cli.StringFlag{Name: `user-gravatar-id`, Usage: ``},
+				cli.BoolFlag{Name: `user-hireable`, Usage: ``},
+				cli.IntFlag{Name: `user-id`, Usage: ``},
+				cli.StringFlag{Name: `user-location`, Usage: ``},
+				cli.StringFlag{Name: `user-login`, Usage: ``},
+				cli.StringFlag{Name: `user-name`, Usage: ``},
 				cli.IntFlag{Name: `user-owned-private-repos`, Usage: ``},
-				cli.IntFlag{Name: `user-collaborators`, Usage: ``},
-				cli.StringFlag{Name: `user-plan-name`, Usage: ``},
-				cli.IntFlag{Name: `user-plan-space`, Usage: ``},
 				cli.IntFlag{Name: `user-plan-collaborators`, Usage: ``},
+				cli.StringFlag{Name: `user-plan-name`, Usage: ``},
 				cli.IntFlag{Name: `user-plan-private-repos`, Usage: ``},
-				cli.IntFlag{Name: `user-id`, Usage: ``},
+				cli.IntFlag{Name: `user-plan-space`, Usage: ``},
+				cli.IntFlag{Name: `user-private-gists`, Usage: ``},
+				cli.IntFlag{Name: `user-public-gists`, Usage: ``},
 				cli.IntFlag{Name: `user-public-repos`, Usage: ``},
-				cli.StringFlag{Name: `user-updated-at`, Usage: ``},
-				cli.StringFlag{Name: `user-bio`, Usage: ``},
 				cli.BoolFlag{Name: `user-site-admin`, Usage: ``},
-				cli.StringFlag{Name: `user-location`, Usage: ``},
-				cli.IntFlag{Name: `user-disk-usage`, Usage: ``},
-				cli.BoolFlag{Name: `user-hireable`, Usage: ``},
-				cli.StringFlag{Name: `user-login`, Usage: ``},
-				cli.StringFlag{Name: `user-company`, Usage: ``},
-				cli.StringFlag{Name: `user-email`, Usage: ``},
-				cli.IntFlag{Name: `user-following`, Usage: ``},
+				cli.IntFlag{Name: `user-total-private-repos`, Usage: ``},
 				cli.StringFlag{Name: `user-type`, Usage: ``},
-				cli.StringFlag{Name: `created-at`, Usage: ``},
-				cli.IntFlag{Name: `id`, Usage: ``},
-				cli.StringFlag{Name: `body`, Usage: ``},
+				cli.StringFlag{Name: `user-updated-at`, Usage: ``},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the GistComment, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 2 {
+				args := c.Args()
+				if len(args) < 2 {
 					showHelp(c, "edit-comment", "edit-comment <gist-id> <comment-id>")
 				}
 
-				gistID := c.Args().Get(0)
-				commentID, err := strconv.Atoi(c.Args().Get(1))
+				gistID := args.Get(0)
+				commentID, err := strconv.Atoi(args.Get(1))
 				check(err)
-				comment := &github.GistComment{
-					ID:        github.Int(c.Int("id")),
-					Body:      github.String(c.String("body")),
-					CreatedAt: timePointer(now.MustParse(c.String("created-at"))),
+				comment := &github.GistComment{}
+				check(decodeFile(c.String("body-file"), comment))
+				if c.IsSet("body") {
+					comment.Body = github.String(c.String("body"))
+				}
+				if c.IsSet("created-at") {
+					comment.CreatedAt = timePointer(timeFlag(c, "created-at"))
+				}
+				if c.IsSet("id") {
+					comment.ID = github.Int(c.Int("id"))
 				}
 
 				result, res, err := app.gh.Gists.EditComment(gistID, commentID, comment)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "GistComment", result)
 
 			},
 		}, cli.Command{
@@ -543,18 +613,23 @@ var GistsService = cli.Command{
 			Description: `delete-comment deletes a gist comment.
 
    GitHub API docs: http://developer.github.com/v3/gists/comments/#delete-a-comment`,
-			Flags: []cli.Flag{},
+			Flags: []cli.Flag{
+				cli.BoolFlag{Name: `yes`, Usage: `Don't ask for confirmation`},
+			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 2 {
+				args := c.Args()
+				if len(args) < 2 {
 					showHelp(c, "delete-comment", "delete-comment <gist-id> <comment-id>")
 				}
 
-				gistID := c.Args().Get(0)
-				commentID, err := strconv.Atoi(c.Args().Get(1))
+				gistID := args.Get(0)
+				commentID, err := strconv.Atoi(args.Get(1))
 				check(err)
 
+				confirm(c, "delete-comment", strconv.Itoa(commentID))
+
 				res, err := app.gh.Gists.DeleteComment(gistID, commentID)
-				checkResponse(res.Response, err)
+				checkResponse(res, err)
 
 			},
 		},
@@ -0,0 +1,146 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// gitRepository tells the host, owner and name of the GitHub repository
+// checked out in the current directory, or one of its parents, from the URL of
+// remote.
+func gitRepository(remote string) (host, owner, repo string, err error) {
+	dir, err := os.Getwd()
+	if err != nil {
+		return "", "", "", err
+	}
+	config, err := findGitConfig(dir)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	data, err := ioutil.ReadFile(config)
+	if err != nil {
+		return "", "", "", err
+	}
+	raw, err := remoteURL(data, remote)
+	if err != nil {
+		return "", "", "", fmt.Errorf("%s: %v", config, err)
+	}
+	return parseRemoteURL(raw)
+}
+
+// findGitConfig looks for the config of the repository holding dir. In linked
+// worktrees and submodules, .git is a file pointing to the actual git dir.
+func findGitConfig(dir string) (string, error) {
+	for {
+		gitDir := filepath.Join(dir, ".git")
+		if fi, err := os.Stat(gitDir); err == nil {
+			if !fi.IsDir() {
+				var err error
+				if gitDir, err = readGitDirLink(gitDir); err != nil {
+					return "", err
+				}
+			}
+			return filepath.Join(gitDir, "config"), nil
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", fmt.Errorf("Not in a git repository")
+		}
+		dir = parent
+	}
+}
+
+func readGitDirLink(name string) (string, error) {
+	data, err := ioutil.ReadFile(name)
+	if err != nil {
+		return "", err
+	}
+
+	line := strings.TrimSpace(string(data))
+	if !strings.HasPrefix(line, "gitdir:") {
+		return "", fmt.Errorf("%s: no gitdir found", name)
+	}
+	gitDir := strings.TrimSpace(strings.TrimPrefix(line, "gitdir:"))
+	if !filepath.IsAbs(gitDir) {
+		gitDir = filepath.Join(filepath.Dir(name), gitDir)
+	}
+
+	// Worktrees share the config of the main repository
+	if common, err := ioutil.ReadFile(filepath.Join(gitDir, "commondir")); err == nil {
+		dir := strings.TrimSpace(string(common))
+		if !filepath.IsAbs(dir) {
+			dir = filepath.Join(gitDir, dir)
+		}
+		return dir, nil
+	}
+	return gitDir, nil
+}
+
+// remoteURL reads the url of [remote "name"] from a git config file.
+func remoteURL(config []byte, name string) (string, error) {
+	section := fmt.Sprintf(`remote "%s"`, name)
+
+	var current string
+	scanner := bufio.NewScanner(bytes.NewReader(config))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "" || line[0] == '#' || line[0] == ';':
+		case line[0] == '[':
+			current = strings.Trim(line, "[]")
+		case current == section:
+			kv := strings.SplitN(line, "=", 2)
+			if len(kv) == 2 && strings.EqualFold(strings.TrimSpace(kv[0]), "url") {
+				return strings.Trim(strings.TrimSpace(kv[1]), `"`), nil
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+	return "", fmt.Errorf("No url for remote %q", name)
+}
+
+// parseRemoteURL extracts the host, owner and repository from the URL of a
+// remote, in any of the forms git accepts:
+//
+//	https://github.com/owner/repo.git
+//	ssh://git@github.com/owner/repo.git
+//	git://github.com/owner/repo.git
+//	git@github.com:owner/repo.git
+func parseRemoteURL(raw string) (host, owner, repo string, err error) {
+	var path string
+	if u, err := url.Parse(raw); err == nil && u.Scheme != "" && u.Host != "" {
+		host, path = u.Host, u.Path
+	} else if i := strings.Index(raw, ":"); i > 0 && !strings.Contains(raw[:i], "/") {
+		host, path = raw[:i], raw[i+1:]
+		if at := strings.LastIndex(host, "@"); at >= 0 {
+			host = host[at+1:]
+		}
+	} else {
+		return "", "", "", fmt.Errorf("Unsupported remote URL %q", raw)
+	}
+
+	parts := strings.Split(strings.TrimSuffix(strings.Trim(path, "/"), ".git"), "/")
+	if len(parts) < 2 || parts[len(parts)-2] == "" || parts[len(parts)-1] == "" {
+		return "", "", "", fmt.Errorf("No owner and repository in remote URL %q", raw)
+	}
+	return stripPort(host), parts[len(parts)-2], parts[len(parts)-1], nil
+}
+
+// stripPort removes the port from host, if any
+func stripPort(host string) string {
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		return h
+	}
+	return host
+}
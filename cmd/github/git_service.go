@@ -1,11 +1,8 @@
 package main
 
 import (
-	"fmt"
-
 	"github.com/codegangsta/cli"
 	"github.com/google/go-github/github"
-	"github.com/kr/pretty"
 )
 
 var GitService = cli.Command{
@@ -21,17 +18,18 @@ var GitService = cli.Command{
    GitHub API docs: http://developer.github.com/v3/git/blobs/#get-a-blob`,
 			Flags: []cli.Flag{},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 3 {
-					showHelp(c, "get-blob", "get-blob <owner> <repo> <sha>")
+				args := repoArgs(c, 1)
+				if len(args) < 3 {
+					showHelp(c, "get-blob", "get-blob [<owner> <repo>] <sha>")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				sha := c.Args().Get(2)
+				owner := args.Get(0)
+				repo := args.Get(1)
+				sha := args.Get(2)
 
 				result, res, err := app.gh.Git.GetBlob(owner, repo, sha)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "Blob", result)
 
 			},
 		}, cli.Command{
@@ -41,28 +39,38 @@ var GitService = cli.Command{
 
    GitHub API docs: http://developer.github.com/v3/git/blobs/#create-a-blob`,
 			Flags: []cli.Flag{
+				cli.StringFlag{Name: `content`, Usage: ``},
 				cli.StringFlag{Name: `encoding`, Usage: ``},
 				cli.StringFlag{Name: `sha`, Usage: ``},
 				cli.IntFlag{Name: `size`, Usage: ``},
-				cli.StringFlag{Name: `content`, Usage: ``},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the Blob, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 2 {
-					showHelp(c, "create-blob", "create-blob <owner> <repo>")
+				args := repoArgs(c, 0)
+				if len(args) < 2 {
+					showHelp(c, "create-blob", "create-blob [<owner> <repo>]")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				blob := &github.Blob{
-					Content:  github.String(c.String("content")),
-					Encoding: github.String(c.String("encoding")),
-					SHA:      github.String(c.String("sha")),
-					Size:     github.Int(c.Int("size")),
+				owner := args.Get(0)
+				repo := args.Get(1)
+				blob := &github.Blob{}
+				check(decodeFile(c.String("body-file"), blob))
+				if c.IsSet("content") {
+					blob.Content = github.String(c.String("content"))
+				}
+				if c.IsSet("encoding") {
+					blob.Encoding = github.String(c.String("encoding"))
+				}
+				if c.IsSet("sha") {
+					blob.SHA = github.String(c.String("sha"))
+				}
+				if c.IsSet("size") {
+					blob.Size = github.Int(c.Int("size"))
 				}
 
 				result, res, err := app.gh.Git.CreateBlob(owner, repo, blob)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "Blob", result)
 
 			},
 		}, cli.Command{
@@ -73,17 +81,18 @@ var GitService = cli.Command{
    GitHub API docs: http://developer.github.com/v3/git/commits/#get-a-commit`,
 			Flags: []cli.Flag{},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 3 {
-					showHelp(c, "get-commit", "get-commit <owner> <repo> <sha>")
+				args := repoArgs(c, 1)
+				if len(args) < 3 {
+					showHelp(c, "get-commit", "get-commit [<owner> <repo>] <sha>")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				sha := c.Args().Get(2)
+				owner := args.Get(0)
+				repo := args.Get(1)
+				sha := args.Get(2)
 
 				result, res, err := app.gh.Git.GetCommit(owner, repo, sha)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "Commit", result)
 
 			},
 		}, cli.Command{
@@ -97,38 +106,46 @@ var GitService = cli.Command{
 
    GitHub API docs: http://developer.github.com/v3/git/commits/#create-a-commit`,
 			Flags: []cli.Flag{
-				cli.StringFlag{Name: `author-email`, Usage: ``},
 				cli.StringFlag{Name: `author-date`, Usage: ``},
+				cli.StringFlag{Name: `author-email`, Usage: ``},
 				cli.StringFlag{Name: `author-name`, Usage: ``},
-				cli.StringFlag{Name: `tree-sha`, Usage: ``},
-				cli.StringFlag{Name: `message`, Usage: ``},
-				cli.IntFlag{Name: `stats-deletions`, Usage: ``},
-				cli.IntFlag{Name: `stats-total`, Usage: ``},
-				cli.IntFlag{Name: `stats-additions`, Usage: ``},
 				cli.IntFlag{Name: `comment-count`, Usage: `CommentCount is the number of GitHub comments on the commit.  This
 is only populated for requests that fetch GitHub data like
 Pulls.ListCommits, Repositories.ListCommits, etc.`},
-				cli.StringFlag{Name: `sha`, Usage: ``},
-				cli.StringFlag{Name: `committer-email`, Usage: ``},
 				cli.StringFlag{Name: `committer-date`, Usage: ``},
+				cli.StringFlag{Name: `committer-email`, Usage: ``},
 				cli.StringFlag{Name: `committer-name`, Usage: ``},
+				cli.StringFlag{Name: `message`, Usage: ``},
+				cli.StringFlag{Name: `sha`, Usage: ``},
+				cli.IntFlag{Name: `stats-additions`, Usage: ``},
+				cli.IntFlag{Name: `stats-deletions`, Usage: ``},
+				cli.IntFlag{Name: `stats-total`, Usage: ``},
+				cli.StringFlag{Name: `tree-sha`, Usage: ``},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the Commit, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 2 {
-					showHelp(c, "create-commit", "create-commit <owner> <repo>")
+				args := repoArgs(c, 0)
+				if len(args) < 2 {
+					showHelp(c, "create-commit", "create-commit [<owner> <repo>]")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				commit := &github.Commit{
-					CommentCount: github.Int(c.Int("comment-count")),
-					SHA:          github.String(c.String("sha")),
-					Message:      github.String(c.String("message")),
+				owner := args.Get(0)
+				repo := args.Get(1)
+				commit := &github.Commit{}
+				check(decodeFile(c.String("body-file"), commit))
+				if c.IsSet("comment-count") {
+					commit.CommentCount = github.Int(c.Int("comment-count"))
+				}
+				if c.IsSet("message") {
+					commit.Message = github.String(c.String("message"))
+				}
+				if c.IsSet("sha") {
+					commit.SHA = github.String(c.String("sha"))
 				}
 
 				result, res, err := app.gh.Git.CreateCommit(owner, repo, commit)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "Commit", result)
 
 			},
 		}, cli.Command{
@@ -139,17 +156,18 @@ Pulls.ListCommits, Repositories.ListCommits, etc.`},
    GitHub API docs: http://developer.github.com/v3/git/refs/#get-a-reference`,
 			Flags: []cli.Flag{},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 3 {
-					showHelp(c, "get-ref", "get-ref <owner> <repo> <ref>")
+				args := repoArgs(c, 1)
+				if len(args) < 3 {
+					showHelp(c, "get-ref", "get-ref [<owner> <repo>] <ref>")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				ref := c.Args().Get(2)
+				owner := args.Get(0)
+				repo := args.Get(1)
+				ref := args.Get(2)
 
 				result, res, err := app.gh.Git.GetRef(owner, repo, ref)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "Reference", result)
 
 			},
 		}, cli.Command{
@@ -159,36 +177,42 @@ Pulls.ListCommits, Repositories.ListCommits, etc.`},
 
    GitHub API docs: http://developer.github.com/v3/git/refs/#get-all-references`,
 			Flags: []cli.Flag{
-				cli.StringFlag{Name: `type`, Usage: ``},
+				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
+				cli.IntFlag{Name: `limit`, Usage: `Stop after this many items, fetching the following pages as needed`},
 				cli.IntFlag{Name: `page`, Usage: `For paginated result sets, page of results to retrieve.`},
 				cli.IntFlag{Name: `per-page`, Usage: `For paginated result sets, the number of results to include per page.`},
-				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
+				cli.StringFlag{Name: `type`, Usage: ``},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the ReferenceListOptions, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 2 {
-					showHelp(c, "list-refs", "list-refs <owner> <repo>")
+				args := repoArgs(c, 0)
+				if len(args) < 2 {
+					showHelp(c, "list-refs", "list-refs [<owner> <repo>]")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				opt := &github.ReferenceListOptions{
-					Type: c.String("type"),
+				owner := args.Get(0)
+				repo := args.Get(1)
+				opt := &github.ReferenceListOptions{}
+				check(decodeFile(c.String("body-file"), opt))
+				if c.IsSet("type") {
+					opt.Type = c.String("type")
+				}
+				if c.IsSet("page") {
+					opt.Page = c.Int("page")
+				}
+				if c.IsSet("per-page") {
+					opt.PerPage = c.Int("per-page")
 				}
 
-				var items []github.Reference
-
-				for {
-					page, res, err := app.gh.Git.ListRefs(owner, repo, opt)
-					checkResponse(res.Response, err)
-
-					items = append(items, page...)
-					if res.NextPage == 0 || !c.Bool("all") {
-						break
-					}
-					opt.Page = res.NextPage
+				if opt.PerPage == 0 {
+					opt.PerPage = app.profile.PerPage
 				}
 
-				fmt.Printf("%# v", pretty.Formatter(items))
+				paginate(c, "Reference", func(page int) (interface{}, *github.Response, error) {
+					opt := *opt
+					opt.Page = page
+					return app.gh.Git.ListRefs(owner, repo, &opt)
+				})
 			},
 		}, cli.Command{
 			Name:  "create-ref",
@@ -197,24 +221,28 @@ Pulls.ListCommits, Repositories.ListCommits, etc.`},
 
    GitHub API docs: http://developer.github.com/v3/git/refs/#create-a-reference`,
 			Flags: []cli.Flag{
-				cli.StringFlag{Name: `ref`, Usage: ``},
 				cli.StringFlag{Name: `object-sha`, Usage: ``},
 				cli.StringFlag{Name: `object-type`, Usage: ``},
+				cli.StringFlag{Name: `ref`, Usage: ``},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the Reference, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 2 {
-					showHelp(c, "create-ref", "create-ref <owner> <repo>")
+				args := repoArgs(c, 0)
+				if len(args) < 2 {
+					showHelp(c, "create-ref", "create-ref [<owner> <repo>]")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				ref := &github.Reference{
-					Ref: github.String(c.String("ref")),
+				owner := args.Get(0)
+				repo := args.Get(1)
+				ref := &github.Reference{}
+				check(decodeFile(c.String("body-file"), ref))
+				if c.IsSet("ref") {
+					ref.Ref = github.String(c.String("ref"))
 				}
 
 				result, res, err := app.gh.Git.CreateRef(owner, repo, ref)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "Reference", result)
 
 			},
 		}, cli.Command{
@@ -224,26 +252,30 @@ Pulls.ListCommits, Repositories.ListCommits, etc.`},
 
    GitHub API docs: http://developer.github.com/v3/git/refs/#update-a-reference`,
 			Flags: []cli.Flag{
-				cli.StringFlag{Name: `ref`, Usage: ``},
-				cli.StringFlag{Name: `object-type`, Usage: ``},
 				cli.StringFlag{Name: `object-sha`, Usage: ``},
+				cli.StringFlag{Name: `object-type`, Usage: ``},
+				cli.StringFlag{Name: `ref`, Usage: ``},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the Reference, - to read from stdin. Flags take precedence over the file`},
 				cli.BoolFlag{Name: `force`, Usage: ``},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 2 {
-					showHelp(c, "update-ref", "update-ref <owner> <repo>")
+				args := repoArgs(c, 0)
+				if len(args) < 2 {
+					showHelp(c, "update-ref", "update-ref [<owner> <repo>]")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				ref := &github.Reference{
-					Ref: github.String(c.String("ref")),
+				owner := args.Get(0)
+				repo := args.Get(1)
+				ref := &github.Reference{}
+				check(decodeFile(c.String("body-file"), ref))
+				if c.IsSet("ref") {
+					ref.Ref = github.String(c.String("ref"))
 				}
 				force := c.Bool("force")
 
 				result, res, err := app.gh.Git.UpdateRef(owner, repo, ref, force)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "Reference", result)
 
 			},
 		}, cli.Command{
@@ -252,18 +284,23 @@ Pulls.ListCommits, Repositories.ListCommits, etc.`},
 			Description: `delete-ref deletes a ref from a repository.
 
    GitHub API docs: http://developer.github.com/v3/git/refs/#delete-a-reference`,
-			Flags: []cli.Flag{},
+			Flags: []cli.Flag{
+				cli.BoolFlag{Name: `yes`, Usage: `Don't ask for confirmation`},
+			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 3 {
-					showHelp(c, "delete-ref", "delete-ref <owner> <repo> <ref>")
+				args := repoArgs(c, 1)
+				if len(args) < 3 {
+					showHelp(c, "delete-ref", "delete-ref [<owner> <repo>] <ref>")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				ref := c.Args().Get(2)
+				owner := args.Get(0)
+				repo := args.Get(1)
+				ref := args.Get(2)
+
+				confirm(c, "delete-ref", repo)
 
 				res, err := app.gh.Git.DeleteRef(owner, repo, ref)
-				checkResponse(res.Response, err)
+				checkResponse(res, err)
 
 			},
 		}, cli.Command{
@@ -274,17 +311,18 @@ Pulls.ListCommits, Repositories.ListCommits, etc.`},
    GitHub API docs: http://developer.github.com/v3/git/tags/#get-a-tag`,
 			Flags: []cli.Flag{},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 3 {
-					showHelp(c, "get-tag", "get-tag <owner> <repo> <sha>")
+				args := repoArgs(c, 1)
+				if len(args) < 3 {
+					showHelp(c, "get-tag", "get-tag [<owner> <repo>] <sha>")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				sha := c.Args().Get(2)
+				owner := args.Get(0)
+				repo := args.Get(1)
+				sha := args.Get(2)
 
 				result, res, err := app.gh.Git.GetTag(owner, repo, sha)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "Tag", result)
 
 			},
 		}, cli.Command{
@@ -295,30 +333,38 @@ Pulls.ListCommits, Repositories.ListCommits, etc.`},
    GitHub API docs: http://developer.github.com/v3/git/tags/#create-a-tag-object`,
 			Flags: []cli.Flag{
 				cli.StringFlag{Name: `message`, Usage: ``},
-				cli.StringFlag{Name: `tagger-date`, Usage: ``},
-				cli.StringFlag{Name: `tagger-name`, Usage: ``},
-				cli.StringFlag{Name: `tagger-email`, Usage: ``},
-				cli.StringFlag{Name: `object-type`, Usage: ``},
 				cli.StringFlag{Name: `object-sha`, Usage: ``},
-				cli.StringFlag{Name: `tag`, Usage: ``},
+				cli.StringFlag{Name: `object-type`, Usage: ``},
 				cli.StringFlag{Name: `sha`, Usage: ``},
+				cli.StringFlag{Name: `tag`, Usage: ``},
+				cli.StringFlag{Name: `tagger-date`, Usage: ``},
+				cli.StringFlag{Name: `tagger-email`, Usage: ``},
+				cli.StringFlag{Name: `tagger-name`, Usage: ``},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the Tag, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 2 {
-					showHelp(c, "create-tag", "create-tag <owner> <repo>")
+				args := repoArgs(c, 0)
+				if len(args) < 2 {
+					showHelp(c, "create-tag", "create-tag [<owner> <repo>]")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				tag := &github.Tag{
-					Tag:     github.String(c.String("tag")),
-					SHA:     github.String(c.String("sha")),
-					Message: github.String(c.String("message")),
+				owner := args.Get(0)
+				repo := args.Get(1)
+				tag := &github.Tag{}
+				check(decodeFile(c.String("body-file"), tag))
+				if c.IsSet("message") {
+					tag.Message = github.String(c.String("message"))
+				}
+				if c.IsSet("sha") {
+					tag.SHA = github.String(c.String("sha"))
+				}
+				if c.IsSet("tag") {
+					tag.Tag = github.String(c.String("tag"))
 				}
 
 				result, res, err := app.gh.Git.CreateTag(owner, repo, tag)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "Tag", result)
 
 			},
 		}, cli.Command{
@@ -331,18 +377,19 @@ Pulls.ListCommits, Repositories.ListCommits, etc.`},
 				cli.BoolFlag{Name: `recursive`, Usage: ``},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 3 {
-					showHelp(c, "get-tree", "get-tree <owner> <repo> <sha>")
+				args := repoArgs(c, 1)
+				if len(args) < 3 {
+					showHelp(c, "get-tree", "get-tree [<owner> <repo>] <sha>")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				sha := c.Args().Get(2)
+				owner := args.Get(0)
+				repo := args.Get(1)
+				sha := args.Get(2)
 				recursive := c.Bool("recursive")
 
 				result, res, err := app.gh.Git.GetTree(owner, repo, sha, recursive)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "Tree", result)
 
 			},
 		}, cli.Command{
@@ -353,20 +400,26 @@ Pulls.ListCommits, Repositories.ListCommits, etc.`},
    that tree with the new path contents and write a new tree out.
 
    GitHub API docs: http://developer.github.com/v3/git/trees/#create-a-tree`,
-			Flags: []cli.Flag{},
+			Flags: []cli.Flag{
+				cli.StringFlag{Name: `entries-file`, Usage: `JSON or YAML file holding the entries, - to read from stdin`},
+				cli.StringSliceFlag{Name: `entry`, Usage: `Add an item as path:mode:type:sha, can be repeated`},
+			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 3 {
-					showHelp(c, "create-tree", "create-tree <owner> <repo> <base-tree>")
+				args := repoArgs(c, 1)
+				if len(args) < 3 {
+					showHelp(c, "create-tree", "create-tree [<owner> <repo>] <base-tree>")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				baseTree := c.Args().Get(2)
+				owner := args.Get(0)
+				repo := args.Get(1)
+				baseTree := args.Get(2)
 				var entries []github.TreeEntry
+				check(decodeFile(c.String("entries-file"), &entries))
+				check(appendFromSpecs(&entries, c.StringSlice("entry"), "Path", "Mode", "Type", "SHA"))
 
 				result, res, err := app.gh.Git.CreateTree(owner, repo, baseTree, entries)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "Tree", result)
 
 			},
 		},
@@ -0,0 +1,77 @@
+package main
+
+import "testing"
+
+func TestParseRemoteURL(t *testing.T) {
+	tests := []struct {
+		raw   string
+		host  string
+		owner string
+		repo  string
+	}{
+		{"https://github.com/owner/repo.git", "github.com", "owner", "repo"},
+		{"https://github.com/owner/repo", "github.com", "owner", "repo"},
+		{"https://user@github.com/owner/repo/", "github.com", "owner", "repo"},
+		{"ssh://git@github.com/owner/repo.git", "github.com", "owner", "repo"},
+		{"ssh://git@github.example.com:2222/owner/repo.git", "github.example.com", "owner", "repo"},
+		{"git://github.com/owner/repo.git", "github.com", "owner", "repo"},
+		{"git@github.com:owner/repo.git", "github.com", "owner", "repo"},
+		{"github.example.com:owner/repo", "github.example.com", "owner", "repo"},
+		{"https://github.example.com/scm/owner/repo.git", "github.example.com", "owner", "repo"},
+	}
+
+	for _, test := range tests {
+		host, owner, repo, err := parseRemoteURL(test.raw)
+		if err != nil {
+			t.Errorf("parseRemoteURL(%q): %v", test.raw, err)
+			continue
+		}
+		if host != test.host || owner != test.owner || repo != test.repo {
+			t.Errorf("parseRemoteURL(%q) = %s, %s, %s, want %s, %s, %s", test.raw, host, owner, repo, test.host, test.owner, test.repo)
+		}
+	}
+
+	for _, raw := range []string{"", "../repo", "/srv/git/repo.git", "https://github.com/repo", "git@github.com:repo.git"} {
+		if _, _, _, err := parseRemoteURL(raw); err == nil {
+			t.Errorf("parseRemoteURL(%q) succeeded, want an error", raw)
+		}
+	}
+}
+
+func TestRemoteURL(t *testing.T) {
+	config := []byte(`[core]
+	repositoryformatversion = 0
+	url = https://example.com/core.git
+; comment
+[remote "origin"]
+	fetch = +refs/heads/*:refs/remotes/origin/*
+	url = git@github.com:owner/repo.git
+[remote "upstream"]
+	URL = "https://github.com/upstream/repo.git"
+[branch "master"]
+	remote = origin
+`)
+
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"origin", "git@github.com:owner/repo.git"},
+		{"upstream", "https://github.com/upstream/repo.git"},
+	}
+
+	for _, test := range tests {
+		got, err := remoteURL(config, test.name)
+		if err != nil {
+			t.Errorf("remoteURL(%q): %v", test.name, err)
+			continue
+		}
+		if got != test.want {
+			t.Errorf("remoteURL(%q) = %q, want %q", test.name, got, test.want)
+		}
+	}
+
+	if got, err := remoteURL(config, "fork"); err == nil {
+		t.Errorf("remoteURL(%q) = %q, want an error", "fork", got)
+	}
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"net/http"
 	"os"
@@ -19,16 +20,16 @@ const (
 )
 
 type application struct {
-	cli *cli.App
-	gh  *github.Client
-}
-
-type tokenSource struct {
-	token *oauth2.Token
-}
-
-func (t tokenSource) Token() (*oauth2.Token, error) {
-	return t.token, nil
+	cli       *cli.App
+	gh        *github.Client
+	http      *http.Client
+	cache     *cacheTransport
+	retry     *retryTransport
+	rateLimit *rateLimitTransport
+	dryRun    *dryRunTransport
+	debug     *debugTransport
+	profile   profile
+	tokens    oauth2.TokenSource
 }
 
 func newApp() *application {
@@ -42,23 +43,151 @@ func newApp() *application {
 	app.cli.HideVersion = true
 	app.cli.HideHelp = true
 	app.cli.Author = "Maxime Bury <maxime.bury@gmail.com>"
-
-	var tc *http.Client
-	if token := os.Getenv("GITHUB_API_TOKEN"); token != "" {
-		tc = oauth2.NewClient(oauth2.NoContext, tokenSource{
-			&oauth2.Token{AccessToken: token},
-		})
+	app.cli.Flags = []cli.Flag{
+		cli.StringFlag{Name: "profile", Value: "default", Usage: "Profile of the config file to use", EnvVar: "GITHUB_PROFILE"},
+		cli.StringFlag{Name: "api-url", Usage: "Root URL of the API, e.g. https://github.example.com for GitHub Enterprise"},
+		cli.StringFlag{Name: "upload-url", Usage: "Root URL for uploads, guessed from --api-url for GitHub Enterprise"},
+		cli.IntFlag{Name: "app-id", Usage: "Authenticate as this GitHub App, instead of with a token", EnvVar: "GITHUB_APP_ID"},
+		cli.StringFlag{Name: "private-key-file", Usage: "PEM file holding the private key of the GitHub App", EnvVar: "GITHUB_PRIVATE_KEY_FILE"},
+		cli.IntFlag{Name: "installation-id", Usage: "Installation of the GitHub App to act as", EnvVar: "GITHUB_INSTALLATION_ID"},
+		cli.BoolFlag{Name: "verbose, v", Usage: "Log requests, statuses, rate limits and timings on stderr"},
+		cli.BoolFlag{Name: "debug", Usage: "Log headers and bodies of requests and responses too"},
+		cli.BoolFlag{Name: "dry-run", Usage: "Print the requests on stderr instead of sending them"},
+		cli.BoolFlag{Name: "curl", Usage: "With --dry-run, print the requests as curl commands"},
+		cli.StringFlag{Name: "remote", Value: "origin", Usage: "Git remote telling the repository when <owner> <repo> are left out", EnvVar: "GITHUB_REMOTE"},
+		cli.StringFlag{Name: "output, format, o", Usage: "Output format: json, yaml, table or pretty, the default"},
+		cli.StringFlag{Name: "fields", Usage: "Comma separated columns for table output, e.g. number,title,user.login"},
+		cli.StringFlag{Name: "template", Usage: "Go template applied to the result, or to each item of a list, e.g. '{{.Number}} {{.Title}}'"},
+		cli.BoolFlag{Name: "wait-on-rate-limit", Usage: "When the rate limit is exhausted, wait for it to reset and retry"},
+		cli.DurationFlag{Name: "max-wait", Value: 15 * time.Minute, Usage: "Longest wait for a rate limit reset"},
+		cli.IntFlag{Name: "retries", Value: 3, Usage: "Retries for requests failing with a network error or a 5xx", EnvVar: "GITHUB_RETRIES"},
+		cli.BoolFlag{Name: "retry-all", Usage: "Also retry requests which aren't idempotent, such as POST", EnvVar: "GITHUB_RETRY_ALL"},
+		cli.DurationFlag{Name: "timeout", Usage: "Timeout for each API call, retries included", EnvVar: "GITHUB_TIMEOUT"},
+		cli.BoolFlag{Name: "cache", Usage: "Keep API responses on disk and revalidate them with conditional requests", EnvVar: "GITHUB_CLI_CACHE"},
+		cli.BoolFlag{Name: "no-cache", Usage: "Bypass the cache, even when enabled with --cache or GITHUB_CLI_CACHE"},
 	}
+	app.cli.Before = func(c *cli.Context) error {
+		var err error
+		app.profile, err = resolveProfile(c)
+		if err != nil {
+			return err
+		}
+
+		if app.profile.BaseURL != "" {
+			app.gh.BaseURL, err = enterpriseURL(app.profile.BaseURL, enterpriseAPIPath)
+			if err != nil {
+				return err
+			}
+			if upload := uploadURL(app.gh.BaseURL); upload != nil {
+				app.gh.UploadURL = upload
+			}
+		}
+		if app.profile.UploadURL != "" {
+			app.gh.UploadURL, err = enterpriseURL(app.profile.UploadURL, enterpriseUploadPath)
+			if err != nil {
+				return err
+			}
+		}
+
+		if appID := c.GlobalInt("app-id"); appID != 0 {
+			if c.GlobalInt("installation-id") == 0 || c.GlobalString("private-key-file") == "" {
+				return errors.New("--app-id needs --installation-id and --private-key-file")
+			}
+			key, err := readPrivateKey(c.GlobalString("private-key-file"))
+			if err != nil {
+				return err
+			}
+			if c.GlobalBool("dry-run") {
+				// The Authorization header is redacted from the requests
+				// printed, so a placeholder spares minting a real token
+				app.authenticate(staticToken("dry-run"))
+			} else {
+				app.authenticate(oauth2.ReuseTokenSource(nil, newStoredTokenSource(&appTokenSource{
+					client:         &http.Client{Transport: app.retry},
+					baseURL:        app.gh.BaseURL,
+					appID:          appID,
+					installationID: c.GlobalInt("installation-id"),
+					key:            key,
+				})))
+			}
+		} else {
+			if app.profile.Token == "" && needsToken(c.Args()) {
+				app.profile.Token, err = loadToken(app.profile.CredentialHelper, app.gh.BaseURL.Host)
+				if err != nil {
+					return err
+				}
+			}
+			app.authenticate(staticToken(app.profile.Token))
+		}
+
+		app.rateLimit.wait = c.GlobalBool("wait-on-rate-limit")
+		app.rateLimit.maxWait = c.GlobalDuration("max-wait")
+		app.retry.retries = c.GlobalInt("retries")
+		app.retry.retryAll = c.GlobalBool("retry-all")
+		app.http.Timeout = c.GlobalDuration("timeout")
+		app.cache.enabled = c.GlobalBool("cache") && !c.GlobalBool("no-cache")
+		app.cache.host = app.gh.BaseURL.Host
+		app.dryRun.enabled = c.GlobalBool("dry-run")
+		app.dryRun.curl = c.GlobalBool("curl")
+		app.debug.verbose = c.GlobalBool("verbose")
+		app.debug.bodies = c.GlobalBool("debug")
+		return nil
+	}
+
+	app.debug = &debugTransport{base: http.DefaultTransport, w: os.Stderr}
+	app.dryRun = &dryRunTransport{base: app.debug, w: os.Stderr}
+	app.rateLimit = &rateLimitTransport{base: app.dryRun, sleep: sleepContext}
+	app.retry = &retryTransport{base: app.rateLimit, sleep: sleepContext}
+	app.cache = &cacheTransport{base: app.retry, dir: cacheDir()}
 
-	app.gh = github.NewClient(tc)
+	app.http = &http.Client{Transport: app.cache}
+	app.gh = github.NewClient(app.http)
 
 	return &app
 }
 
+// needsToken tells whether the command given by args sends the stored token.
+// The config commands and auth login and logout don't, and must not fail on a
+// broken credential helper since they are the ones fixing it.
+func needsToken(args cli.Args) bool {
+	switch args.First() {
+	case "config":
+		return false
+	case "auth":
+		return args.Get(1) != "login" && args.Get(1) != "logout"
+	default:
+		return true
+	}
+}
+
+// authenticate sends a token of source with every request, or no credentials
+// when source is nil
+func (a *application) authenticate(source oauth2.TokenSource) {
+	a.tokens = source
+	if source == nil {
+		a.http.Transport = a.cache
+		return
+	}
+	a.http.Transport = &oauth2.Transport{
+		Source: source,
+		Base:   a.cache,
+	}
+}
+
+// staticToken is the source of a personal or OAuth token, nil when empty
+func staticToken(token string) oauth2.TokenSource {
+	if token == "" {
+		return nil
+	}
+	return oauth2.StaticTokenSource(&oauth2.Token{AccessToken: token})
+}
+
 var app = newApp()
 
 func main() {
-	app.cli.Run(os.Args)
+	if err := app.cli.Run(os.Args); err != nil {
+		exit(err)
+	}
 }
 
 func fixHelp(c *cli.Context) {
@@ -85,14 +214,17 @@ func fatalln(v ...interface{}) {
 
 func check(err error) {
 	if err != nil {
-		fmt.Println(err)
-		os.Exit(1)
+		exit(err)
 	}
 }
 
-func checkResponse(res *http.Response, err error) {
+// checkResponse is check for API calls. res is nil when no response was
+// received.
+func checkResponse(res *github.Response, err error) {
 	check(err)
-	check(github.CheckResponse(res))
+	if res != nil {
+		check(checkStatus(res.Response))
+	}
 }
 
 func timePointer(t time.Time) *time.Time {
@@ -0,0 +1,28 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/codegangsta/cli"
+)
+
+func TestNeedsToken(t *testing.T) {
+	tests := []struct {
+		args []string
+		want bool
+	}{
+		{[]string{"issues", "list"}, true},
+		{[]string{"config", "set", "credential_helper", "store"}, false},
+		{[]string{"auth", "login"}, false},
+		{[]string{"auth", "logout"}, false},
+		{[]string{"auth", "status"}, true},
+		{[]string{"auth", "token"}, true},
+		{nil, true},
+	}
+
+	for _, test := range tests {
+		if got := needsToken(cli.Args(test.args)); got != test.want {
+			t.Errorf("needsToken(%q) = %t, want %t", test.args, got, test.want)
+		}
+	}
+}
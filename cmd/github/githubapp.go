@@ -0,0 +1,219 @@
+package main
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2"
+	"gopkg.in/yaml.v2"
+)
+
+// appTokenSource authenticates as a GitHub App installation. Each token is
+// obtained with a JWT signed by the private key of the app, and is valid for an
+// hour, so it is meant to be wrapped in a storedTokenSource.
+type appTokenSource struct {
+	client         *http.Client
+	baseURL        *url.URL
+	appID          int
+	installationID int
+	key            *rsa.PrivateKey
+}
+
+func (s *appTokenSource) Token() (*oauth2.Token, error) {
+	jwt, err := s.jwt(time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := s.baseURL.Parse(fmt.Sprintf("app/installations/%d/access_tokens", s.installationID))
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+jwt)
+	req.Header.Set("Accept", "application/vnd.github.machine-man-preview+json")
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusCreated {
+		body, _ := ioutil.ReadAll(res.Body)
+		return nil, &cliError{
+			Code:    statusCode(res.StatusCode),
+			Status:  res.StatusCode,
+			Message: fmt.Sprintf("Could not get an installation token: %s %s", res.Status, strings.TrimSpace(string(body))),
+		}
+	}
+
+	var token struct {
+		Token     string    `json:"token"`
+		ExpiresAt time.Time `json:"expires_at"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&token); err != nil {
+		return nil, err
+	}
+	return &oauth2.Token{AccessToken: token.Token, Expiry: token.ExpiresAt}, nil
+}
+
+// Stored installation tokens are renewed this long before they expire, so that
+// they don't expire in the middle of a command.
+const tokenRenewal = 5 * time.Minute
+
+func appTokensPath() string {
+	return filepath.Join(filepath.Dir(configPath()), "app-tokens.yaml")
+}
+
+type storedToken struct {
+	Token     string    `yaml:"token"`
+	ExpiresAt time.Time `yaml:"expires_at"`
+}
+
+// storedTokenSource keeps the tokens of base in the app tokens file under key,
+// so that following invocations reuse them until they are about to expire.
+type storedTokenSource struct {
+	base oauth2.TokenSource
+	key  string
+	now  func() time.Time
+}
+
+// newStoredTokenSource stores the installation tokens of s per API host, app
+// and installation.
+func newStoredTokenSource(s *appTokenSource) oauth2.TokenSource {
+	return &storedTokenSource{
+		base: s,
+		key:  fmt.Sprintf("%s/%d/%d", s.baseURL.Host, s.appID, s.installationID),
+		now:  time.Now,
+	}
+}
+
+func (s *storedTokenSource) Token() (*oauth2.Token, error) {
+	tokens, err := loadAppTokens()
+	if err != nil {
+		return nil, err
+	}
+	if stored, ok := tokens[s.key]; ok && s.now().Add(tokenRenewal).Before(stored.ExpiresAt) {
+		return &oauth2.Token{AccessToken: stored.Token, Expiry: stored.ExpiresAt}, nil
+	}
+
+	token, err := s.base.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	// Drop the expired tokens of other installations along the way
+	for key, stored := range tokens {
+		if !s.now().Before(stored.ExpiresAt) {
+			delete(tokens, key)
+		}
+	}
+	tokens[s.key] = storedToken{Token: token.AccessToken, ExpiresAt: token.Expiry}
+	if err := saveAppTokens(tokens); err != nil {
+		return nil, err
+	}
+	return token, nil
+}
+
+func loadAppTokens() (map[string]storedToken, error) {
+	tokens := map[string]storedToken{}
+
+	data, err := ioutil.ReadFile(appTokensPath())
+	if os.IsNotExist(err) {
+		return tokens, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := yaml.Unmarshal(data, &tokens); err != nil {
+		return nil, fmt.Errorf("%s: %v", appTokensPath(), err)
+	}
+	return tokens, nil
+}
+
+// saveAppTokens writes the file readable only by the user, like the
+// credentials file.
+func saveAppTokens(tokens map[string]storedToken) error {
+	data, err := yaml.Marshal(tokens)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(appTokensPath()), 0700); err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(appTokensPath(), data, 0600); err != nil {
+		return err
+	}
+	return os.Chmod(appTokensPath(), 0600)
+}
+
+// jwt signs the claims identifying the app with RS256. GitHub accepts them for
+// 10 minutes at most, and the issue date is backdated to allow for clock skew.
+func (s *appTokenSource) jwt(now time.Time) (string, error) {
+	header, err := json.Marshal(map[string]string{"alg": "RS256", "typ": "JWT"})
+	if err != nil {
+		return "", err
+	}
+	claims, err := json.Marshal(map[string]interface{}{
+		"iat": now.Add(-time.Minute).Unix(),
+		"exp": now.Add(9 * time.Minute).Unix(),
+		"iss": s.appID,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	unsigned := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(claims)
+	hash := sha256.Sum256([]byte(unsigned))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, s.key, crypto.SHA256, hash[:])
+	if err != nil {
+		return "", err
+	}
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+// readPrivateKey reads the PEM encoded key downloaded from the settings of the
+// app.
+func readPrivateKey(name string) (*rsa.PrivateKey, error) {
+	data, err := ioutil.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("%s: no PEM encoded key found", name)
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", name, err)
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("%s: not an RSA key", name)
+	}
+	return rsaKey, nil
+}
@@ -0,0 +1,193 @@
+package main
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+func testAppTokenSource(t *testing.T, baseURL string) *appTokenSource {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &appTokenSource{client: http.DefaultClient, baseURL: u, appID: 1234, installationID: 42, key: key}
+}
+
+// verifyJWT checks the signature of jwt with the public key of s, and returns
+// its claims.
+func verifyJWT(t *testing.T, s *appTokenSource, jwt string) map[string]interface{} {
+	parts := strings.Split(jwt, ".")
+	if len(parts) != 3 {
+		t.Fatalf("JWT %q doesn't have 3 parts", jwt)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		t.Fatal(err)
+	}
+	hash := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err := rsa.VerifyPKCS1v15(&s.key.PublicKey, crypto.SHA256, hash[:], signature); err != nil {
+		t.Fatalf("invalid signature: %v", err)
+	}
+
+	var header map[string]string
+	data, _ := base64.RawURLEncoding.DecodeString(parts[0])
+	if err := json.Unmarshal(data, &header); err != nil || header["alg"] != "RS256" {
+		t.Errorf("header = %s, want RS256", data)
+	}
+
+	var claims map[string]interface{}
+	data, _ = base64.RawURLEncoding.DecodeString(parts[1])
+	if err := json.Unmarshal(data, &claims); err != nil {
+		t.Fatal(err)
+	}
+	return claims
+}
+
+func TestJWT(t *testing.T) {
+	s := testAppTokenSource(t, "https://api.github.com/")
+	now := time.Unix(1434000000, 0)
+
+	jwt, err := s.jwt(now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	claims := verifyJWT(t, s, jwt)
+
+	want := map[string]float64{
+		"iat": float64(now.Add(-time.Minute).Unix()),
+		"exp": float64(now.Add(9 * time.Minute).Unix()),
+		"iss": 1234,
+	}
+	for name, value := range want {
+		if claims[name] != value {
+			t.Errorf("claim %s = %v, want %v", name, claims[name], value)
+		}
+	}
+}
+
+func TestAppTokenSource(t *testing.T) {
+	expiry := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+
+	var s *appTokenSource
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/app/installations/42/access_tokens" {
+			t.Errorf("got %s %s, want POST /app/installations/42/access_tokens", r.Method, r.URL.Path)
+		}
+		auth := r.Header.Get("Authorization")
+		if !strings.HasPrefix(auth, "Bearer ") {
+			t.Errorf("Authorization = %q, want a bearer JWT", auth)
+		} else if claims := verifyJWT(t, s, strings.TrimPrefix(auth, "Bearer ")); claims["iss"] != float64(1234) {
+			t.Errorf("iss = %v, want 1234", claims["iss"])
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{"token": "v1.installation", "expires_at": %q}`, expiry.Format(time.RFC3339))
+	}))
+	defer server.Close()
+
+	s = testAppTokenSource(t, server.URL+"/")
+	token, err := s.Token()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token.AccessToken != "v1.installation" || !token.Expiry.Equal(expiry) {
+		t.Errorf("got token %q expiring at %s, want %q expiring at %s", token.AccessToken, token.Expiry, "v1.installation", expiry)
+	}
+}
+
+func TestAppTokenSourceRejected(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		fmt.Fprint(w, `{"message": "A JSON web token could not be decoded"}`)
+	}))
+	defer server.Close()
+
+	_, err := testAppTokenSource(t, server.URL+"/").Token()
+	if err == nil {
+		t.Fatal("got no error")
+	}
+	if code := classify(err).Code; code != exitUnauthorized {
+		t.Errorf("exit code = %d, want %d", code, exitUnauthorized)
+	}
+}
+
+// countingTokenSource returns a new token valid for an hour on each call
+type countingTokenSource struct {
+	now   func() time.Time
+	calls int
+}
+
+func (s *countingTokenSource) Token() (*oauth2.Token, error) {
+	s.calls++
+	return &oauth2.Token{AccessToken: fmt.Sprintf("token-%d", s.calls), Expiry: s.now().Add(time.Hour)}, nil
+}
+
+func TestStoredTokenSource(t *testing.T) {
+	dir, err := ioutil.TempDir("", "config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	defer os.Setenv("XDG_CONFIG_HOME", os.Getenv("XDG_CONFIG_HOME"))
+	os.Setenv("XDG_CONFIG_HOME", dir)
+
+	now := time.Unix(1434000000, 0)
+	clock := func() time.Time { return now }
+	base := &countingTokenSource{now: clock}
+	source := func() oauth2.TokenSource {
+		// A new source each time, like following invocations
+		return &storedTokenSource{base: base, key: "api.github.com/1234/42", now: clock}
+	}
+
+	tests := []struct {
+		name    string
+		elapsed time.Duration
+		token   string
+	}{
+		{"first", 0, "token-1"},
+		{"stored", 30 * time.Minute, "token-1"},
+		{"before renewal", time.Hour - tokenRenewal - time.Second, "token-1"},
+		{"within renewal", time.Hour - tokenRenewal, "token-2"},
+		{"renewed", time.Hour, "token-2"},
+	}
+
+	start := now
+	for _, test := range tests {
+		now = start.Add(test.elapsed)
+		token, err := source().Token()
+		if err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+		if token.AccessToken != test.token {
+			t.Errorf("%s: got %q, want %q", test.name, token.AccessToken, test.token)
+		}
+	}
+
+	fi, err := os.Stat(appTokensPath())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mode := fi.Mode().Perm(); mode != 0600 {
+		t.Errorf("app tokens file mode = %o, want 600", mode)
+	}
+}
@@ -0,0 +1,124 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// decodeFile reads JSON or YAML from name, or from stdin when name is "-", into
+// v. Nothing happens when name is empty.
+func decodeFile(name string, v interface{}) error {
+	if name == "" {
+		return nil
+	}
+
+	var data []byte
+	var err error
+	if name == "-" {
+		data, err = ioutil.ReadAll(os.Stdin)
+	} else {
+		data, err = ioutil.ReadFile(name)
+	}
+	if err != nil {
+		return err
+	}
+
+	return decodeYAML(data, v)
+}
+
+// decodeYAML goes through JSON so the go-github json tags are honoured. JSON
+// being a subset of YAML, it accepts both.
+func decodeYAML(data []byte, v interface{}) error {
+	var generic interface{}
+	if err := yaml.Unmarshal(data, &generic); err != nil {
+		return err
+	}
+
+	j, err := json.Marshal(jsonCompatible(generic))
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(j, v)
+}
+
+// jsonCompatible converts the map[interface{}]interface{} produced by the yaml
+// package into map[string]interface{} that encoding/json can handle.
+func jsonCompatible(v interface{}) interface{} {
+	switch value := v.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(value))
+		for k, item := range value {
+			m[fmt.Sprint(k)] = jsonCompatible(item)
+		}
+		return m
+	case []interface{}:
+		for i, item := range value {
+			value[i] = jsonCompatible(item)
+		}
+		return value
+	default:
+		return v
+	}
+}
+
+// appendFromSpecs parses each colon separated spec into a new item of the slice
+// pointed to by slice, setting the given fields in order. The last fields are
+// taken from the right, so that the first one, such as a path, may hold colons.
+func appendFromSpecs(slice interface{}, specs []string, fields ...string) error {
+	items := reflect.ValueOf(slice).Elem()
+
+	for _, spec := range specs {
+		values := strings.Split(spec, ":")
+		if len(values) < len(fields) {
+			return fmt.Errorf("invalid value %q, expected %s", spec, strings.ToLower(strings.Join(fields, ":")))
+		}
+		extra := len(values) - len(fields)
+		values = append([]string{strings.Join(values[:extra+1], ":")}, values[extra+1:]...)
+
+		item := reflect.New(items.Type().Elem()).Elem()
+		for i, name := range fields {
+			if err := setField(item.FieldByName(name), values[i]); err != nil {
+				return fmt.Errorf("invalid %s in %q: %v", strings.ToLower(name), spec, err)
+			}
+		}
+		items.Set(reflect.Append(items, item))
+	}
+
+	return nil
+}
+
+func setField(field reflect.Value, value string) error {
+	if field.Kind() == reflect.Ptr {
+		field.Set(reflect.New(field.Type().Elem()))
+		field = field.Elem()
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(value)
+	case reflect.Int:
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return err
+		}
+		field.SetInt(int64(n))
+	case reflect.Bool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		field.SetBool(b)
+	default:
+		return fmt.Errorf("unsupported field type %s", field.Type())
+	}
+
+	return nil
+}
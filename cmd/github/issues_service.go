@@ -1,13 +1,10 @@
 package main
 
 import (
-	"fmt"
 	"strconv"
 
 	"github.com/codegangsta/cli"
 	"github.com/google/go-github/github"
-	"github.com/jinzhu/now"
-	"github.com/kr/pretty"
 )
 
 var IssuesService = cli.Command{
@@ -26,46 +23,61 @@ var IssuesService = cli.Command{
    GitHub API docs: http://developer.github.com/v3/issues/#list-issues`,
 			Flags: []cli.Flag{
 				cli.BoolFlag{Name: `all`, Usage: ``},
+				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
 				cli.StringFlag{Name: `direction`, Usage: `Direction in which to sort issues.  Possible values are: asc, desc.
 Default is "asc".`},
-				cli.StringFlag{Name: `since`, Usage: `Since filters issues by time.`},
-				cli.IntFlag{Name: `page`, Usage: `For paginated result sets, page of results to retrieve.`},
-				cli.IntFlag{Name: `per-page`, Usage: `For paginated result sets, the number of results to include per page.`},
-				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
 				cli.StringFlag{Name: `filter`, Usage: `Filter specifies which issues to list.  Possible values are: assigned,
 created, mentioned, subscribed, all.  Default is "assigned".`},
-				cli.StringFlag{Name: `state`, Usage: `State filters issues based on their state.  Possible values are: open,
-closed.  Default is "open".`},
 				cli.StringSliceFlag{Name: `labels`, Usage: `Labels filters issues based on their label.`},
+				cli.IntFlag{Name: `limit`, Usage: `Stop after this many items, fetching the following pages as needed`},
+				cli.IntFlag{Name: `page`, Usage: `For paginated result sets, page of results to retrieve.`},
+				cli.IntFlag{Name: `per-page`, Usage: `For paginated result sets, the number of results to include per page.`},
+				cli.StringFlag{Name: `since`, Usage: `Since filters issues by time.`},
 				cli.StringFlag{Name: `sort`, Usage: `Sort specifies how to sort issues.  Possible values are: created, updated,
 and comments.  Default value is "created".`},
+				cli.StringFlag{Name: `state`, Usage: `State filters issues based on their state.  Possible values are: open,
+closed.  Default is "open".`},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the IssueListOptions, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
 				all := c.Bool("all")
 
-				opt := &github.IssueListOptions{
-					Filter:    c.String("filter"),
-					State:     c.String("state"),
-					Labels:    c.StringSlice("labels"),
-					Sort:      c.String("sort"),
-					Direction: c.String("direction"),
-					Since:     now.MustParse(c.String("since")),
+				opt := &github.IssueListOptions{}
+				check(decodeFile(c.String("body-file"), opt))
+				if c.IsSet("direction") {
+					opt.Direction = c.String("direction")
+				}
+				if c.IsSet("filter") {
+					opt.Filter = c.String("filter")
+				}
+				if c.IsSet("labels") {
+					opt.Labels = c.StringSlice("labels")
+				}
+				if c.IsSet("since") {
+					opt.Since = timeFlag(c, "since")
+				}
+				if c.IsSet("sort") {
+					opt.Sort = c.String("sort")
+				}
+				if c.IsSet("state") {
+					opt.State = c.String("state")
+				}
+				if c.IsSet("page") {
+					opt.Page = c.Int("page")
+				}
+				if c.IsSet("per-page") {
+					opt.PerPage = c.Int("per-page")
 				}
 
-				var items []github.Issue
-
-				for {
-					page, res, err := app.gh.Issues.List(all, opt)
-					checkResponse(res.Response, err)
-
-					items = append(items, page...)
-					if res.NextPage == 0 || !c.Bool("all") {
-						break
-					}
-					opt.Page = res.NextPage
+				if opt.PerPage == 0 {
+					opt.PerPage = app.profile.PerPage
 				}
 
-				fmt.Printf("%# v", pretty.Formatter(items))
+				paginate(c, "Issue", func(page int) (interface{}, *github.Response, error) {
+					opt := *opt
+					opt.Page = page
+					return app.gh.Issues.List(all, &opt)
+				})
 			},
 		}, cli.Command{
 			Name:  "list-by-org",
@@ -75,49 +87,65 @@ and comments.  Default value is "created".`},
 
    GitHub API docs: http://developer.github.com/v3/issues/#list-issues`,
 			Flags: []cli.Flag{
-				cli.StringFlag{Name: `sort`, Usage: `Sort specifies how to sort issues.  Possible values are: created, updated,
-and comments.  Default value is "created".`},
+				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
 				cli.StringFlag{Name: `direction`, Usage: `Direction in which to sort issues.  Possible values are: asc, desc.
 Default is "asc".`},
-				cli.StringFlag{Name: `since`, Usage: `Since filters issues by time.`},
-				cli.IntFlag{Name: `page`, Usage: `For paginated result sets, page of results to retrieve.`},
-				cli.IntFlag{Name: `per-page`, Usage: `For paginated result sets, the number of results to include per page.`},
-				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
 				cli.StringFlag{Name: `filter`, Usage: `Filter specifies which issues to list.  Possible values are: assigned,
 created, mentioned, subscribed, all.  Default is "assigned".`},
+				cli.StringSliceFlag{Name: `labels`, Usage: `Labels filters issues based on their label.`},
+				cli.IntFlag{Name: `limit`, Usage: `Stop after this many items, fetching the following pages as needed`},
+				cli.IntFlag{Name: `page`, Usage: `For paginated result sets, page of results to retrieve.`},
+				cli.IntFlag{Name: `per-page`, Usage: `For paginated result sets, the number of results to include per page.`},
+				cli.StringFlag{Name: `since`, Usage: `Since filters issues by time.`},
+				cli.StringFlag{Name: `sort`, Usage: `Sort specifies how to sort issues.  Possible values are: created, updated,
+and comments.  Default value is "created".`},
 				cli.StringFlag{Name: `state`, Usage: `State filters issues based on their state.  Possible values are: open,
 closed.  Default is "open".`},
-				cli.StringSliceFlag{Name: `labels`, Usage: `Labels filters issues based on their label.`},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the IssueListOptions, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 1 {
+				args := c.Args()
+				if len(args) < 1 {
 					showHelp(c, "list-by-org", "list-by-org <org>")
 				}
 
-				org := c.Args().Get(0)
-				opt := &github.IssueListOptions{
-					Sort:      c.String("sort"),
-					Direction: c.String("direction"),
-					Since:     now.MustParse(c.String("since")),
-					Filter:    c.String("filter"),
-					State:     c.String("state"),
-					Labels:    c.StringSlice("labels"),
+				org := args.Get(0)
+				opt := &github.IssueListOptions{}
+				check(decodeFile(c.String("body-file"), opt))
+				if c.IsSet("direction") {
+					opt.Direction = c.String("direction")
+				}
+				if c.IsSet("filter") {
+					opt.Filter = c.String("filter")
+				}
+				if c.IsSet("labels") {
+					opt.Labels = c.StringSlice("labels")
+				}
+				if c.IsSet("since") {
+					opt.Since = timeFlag(c, "since")
+				}
+				if c.IsSet("sort") {
+					opt.Sort = c.String("sort")
+				}
+				if c.IsSet("state") {
+					opt.State = c.String("state")
+				}
+				if c.IsSet("page") {
+					opt.Page = c.Int("page")
+				}
+				if c.IsSet("per-page") {
+					opt.PerPage = c.Int("per-page")
 				}
 
-				var items []github.Issue
-
-				for {
-					page, res, err := app.gh.Issues.ListByOrg(org, opt)
-					checkResponse(res.Response, err)
-
-					items = append(items, page...)
-					if res.NextPage == 0 || !c.Bool("all") {
-						break
-					}
-					opt.Page = res.NextPage
+				if opt.PerPage == 0 {
+					opt.PerPage = app.profile.PerPage
 				}
 
-				fmt.Printf("%# v", pretty.Formatter(items))
+				paginate(c, "Issue", func(page int) (interface{}, *github.Response, error) {
+					opt := *opt
+					opt.Page = page
+					return app.gh.Issues.ListByOrg(org, &opt)
+				})
 			},
 		}, cli.Command{
 			Name:  "list-by-repo",
@@ -126,59 +154,81 @@ closed.  Default is "open".`},
 
    GitHub API docs: http://developer.github.com/v3/issues/#list-issues-for-a-repository`,
 			Flags: []cli.Flag{
-				cli.StringFlag{Name: `state`, Usage: `State filters issues based on their state.  Possible values are: open,
-closed.  Default is "open".`},
-				cli.StringFlag{Name: `mentioned`, Usage: `Assignee filters issues to those mentioned a specific user.`},
-				cli.StringFlag{Name: `direction`, Usage: `Direction in which to sort issues.  Possible values are: asc, desc.
-Default is "asc".`},
-				cli.StringFlag{Name: `since`, Usage: `Since filters issues by time.`},
-				cli.IntFlag{Name: `page`, Usage: `For paginated result sets, page of results to retrieve.`},
-				cli.IntFlag{Name: `per-page`, Usage: `For paginated result sets, the number of results to include per page.`},
 				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
-				cli.StringFlag{Name: `milestone`, Usage: `Milestone limits issues for the specified milestone.  Possible values are
-a milestone number, "none" for issues with no milestone, "*" for issues
-with any milestone.`},
 				cli.StringFlag{Name: `assignee`, Usage: `Assignee filters issues based on their assignee.  Possible values are a
 user name, "none" for issues that are not assigned, "*" for issues with
 any assigned user.`},
 				cli.StringFlag{Name: `creator`, Usage: `Assignee filters issues based on their creator.`},
+				cli.StringFlag{Name: `direction`, Usage: `Direction in which to sort issues.  Possible values are: asc, desc.
+Default is "asc".`},
 				cli.StringSliceFlag{Name: `labels`, Usage: `Labels filters issues based on their label.`},
+				cli.IntFlag{Name: `limit`, Usage: `Stop after this many items, fetching the following pages as needed`},
+				cli.StringFlag{Name: `mentioned`, Usage: `Assignee filters issues to those mentioned a specific user.`},
+				cli.StringFlag{Name: `milestone`, Usage: `Milestone limits issues for the specified milestone.  Possible values are
+a milestone number, "none" for issues with no milestone, "*" for issues
+with any milestone.`},
+				cli.IntFlag{Name: `page`, Usage: `For paginated result sets, page of results to retrieve.`},
+				cli.IntFlag{Name: `per-page`, Usage: `For paginated result sets, the number of results to include per page.`},
+				cli.StringFlag{Name: `since`, Usage: `Since filters issues by time.`},
 				cli.StringFlag{Name: `sort`, Usage: `Sort specifies how to sort issues.  Possible values are: created, updated,
 and comments.  Default value is "created".`},
+				cli.StringFlag{Name: `state`, Usage: `State filters issues based on their state.  Possible values are: open,
+closed.  Default is "open".`},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the IssueListByRepoOptions, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 2 {
-					showHelp(c, "list-by-repo", "list-by-repo <owner> <repo>")
+				args := repoArgs(c, 0)
+				if len(args) < 2 {
+					showHelp(c, "list-by-repo", "list-by-repo [<owner> <repo>]")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				opt := &github.IssueListByRepoOptions{
-					Creator:   c.String("creator"),
-					Labels:    c.StringSlice("labels"),
-					Sort:      c.String("sort"),
-					Milestone: c.String("milestone"),
-					Assignee:  c.String("assignee"),
-					Direction: c.String("direction"),
-					Since:     now.MustParse(c.String("since")),
-					State:     c.String("state"),
-					Mentioned: c.String("mentioned"),
+				owner := args.Get(0)
+				repo := args.Get(1)
+				opt := &github.IssueListByRepoOptions{}
+				check(decodeFile(c.String("body-file"), opt))
+				if c.IsSet("assignee") {
+					opt.Assignee = c.String("assignee")
+				}
+				if c.IsSet("creator") {
+					opt.Creator = c.String("creator")
+				}
+				if c.IsSet("direction") {
+					opt.Direction = c.String("direction")
+				}
+				if c.IsSet("labels") {
+					opt.Labels = c.StringSlice("labels")
+				}
+				if c.IsSet("mentioned") {
+					opt.Mentioned = c.String("mentioned")
+				}
+				if c.IsSet("milestone") {
+					opt.Milestone = c.String("milestone")
+				}
+				if c.IsSet("since") {
+					opt.Since = timeFlag(c, "since")
+				}
+				if c.IsSet("sort") {
+					opt.Sort = c.String("sort")
+				}
+				if c.IsSet("state") {
+					opt.State = c.String("state")
+				}
+				if c.IsSet("page") {
+					opt.Page = c.Int("page")
+				}
+				if c.IsSet("per-page") {
+					opt.PerPage = c.Int("per-page")
 				}
 
-				var items []github.Issue
-
-				for {
-					page, res, err := app.gh.Issues.ListByRepo(owner, repo, opt)
-					checkResponse(res.Response, err)
-
-					items = append(items, page...)
-					if res.NextPage == 0 || !c.Bool("all") {
-						break
-					}
-					opt.Page = res.NextPage
+				if opt.PerPage == 0 {
+					opt.PerPage = app.profile.PerPage
 				}
 
-				fmt.Printf("%# v", pretty.Formatter(items))
+				paginate(c, "Issue", func(page int) (interface{}, *github.Response, error) {
+					opt := *opt
+					opt.Page = page
+					return app.gh.Issues.ListByRepo(owner, repo, &opt)
+				})
 			},
 		}, cli.Command{
 			Name:  "get",
@@ -188,18 +238,19 @@ and comments.  Default value is "created".`},
    GitHub API docs: http://developer.github.com/v3/issues/#get-a-single-issue`,
 			Flags: []cli.Flag{},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 3 {
-					showHelp(c, "get", "get <owner> <repo> <number>")
+				args := repoArgs(c, 1)
+				if len(args) < 3 {
+					showHelp(c, "get", "get [<owner> <repo>] <number>")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				number, err := strconv.Atoi(c.Args().Get(2))
+				owner := args.Get(0)
+				repo := args.Get(1)
+				number, err := strconv.Atoi(args.Get(2))
 				check(err)
 
 				result, res, err := app.gh.Issues.Get(owner, repo, number)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "Issue", result)
 
 			},
 		}, cli.Command{
@@ -209,32 +260,46 @@ and comments.  Default value is "created".`},
 
    GitHub API docs: http://developer.github.com/v3/issues/#create-an-issue`,
 			Flags: []cli.Flag{
-				cli.StringFlag{Name: `title`, Usage: ``},
+				cli.StringFlag{Name: `assignee`, Usage: ``},
 				cli.StringFlag{Name: `body`, Usage: ``},
 				cli.StringSliceFlag{Name: `labels`, Usage: ``},
-				cli.StringFlag{Name: `assignee`, Usage: ``},
-				cli.StringFlag{Name: `state`, Usage: ``},
 				cli.IntFlag{Name: `milestone`, Usage: ``},
+				cli.StringFlag{Name: `state`, Usage: ``},
+				cli.StringFlag{Name: `title`, Usage: ``},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the IssueRequest, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 2 {
-					showHelp(c, "create", "create <owner> <repo>")
+				args := repoArgs(c, 0)
+				if len(args) < 2 {
+					showHelp(c, "create", "create [<owner> <repo>]")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				issue := &github.IssueRequest{
-					Title:     github.String(c.String("title")),
-					Body:      github.String(c.String("body")),
-					Labels:    stringSlicePointer(c.StringSlice("labels")),
-					Assignee:  github.String(c.String("assignee")),
-					State:     github.String(c.String("state")),
-					Milestone: github.Int(c.Int("milestone")),
+				owner := args.Get(0)
+				repo := args.Get(1)
+				issue := &github.IssueRequest{}
+				check(decodeFile(c.String("body-file"), issue))
+				if c.IsSet("assignee") {
+					issue.Assignee = github.String(c.String("assignee"))
+				}
+				if c.IsSet("body") {
+					issue.Body = github.String(c.String("body"))
+				}
+				if c.IsSet("labels") {
+					issue.Labels = stringSlicePointer(c.StringSlice("labels"))
+				}
+				if c.IsSet("milestone") {
+					issue.Milestone = github.Int(c.Int("milestone"))
+				}
+				if c.IsSet("state") {
+					issue.State = github.String(c.String("state"))
+				}
+				if c.IsSet("title") {
+					issue.Title = github.String(c.String("title"))
 				}
 
 				result, res, err := app.gh.Issues.Create(owner, repo, issue)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "Issue", result)
 
 			},
 		}, cli.Command{
@@ -244,34 +309,48 @@ and comments.  Default value is "created".`},
 
    GitHub API docs: http://developer.github.com/v3/issues/#edit-an-issue`,
 			Flags: []cli.Flag{
-				cli.StringFlag{Name: `title`, Usage: ``},
+				cli.StringFlag{Name: `assignee`, Usage: ``},
 				cli.StringFlag{Name: `body`, Usage: ``},
 				cli.StringSliceFlag{Name: `labels`, Usage: ``},
-				cli.StringFlag{Name: `assignee`, Usage: ``},
-				cli.StringFlag{Name: `state`, Usage: ``},
 				cli.IntFlag{Name: `milestone`, Usage: ``},
+				cli.StringFlag{Name: `state`, Usage: ``},
+				cli.StringFlag{Name: `title`, Usage: ``},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the IssueRequest, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 3 {
-					showHelp(c, "edit", "edit <owner> <repo> <number>")
+				args := repoArgs(c, 1)
+				if len(args) < 3 {
+					showHelp(c, "edit", "edit [<owner> <repo>] <number>")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				number, err := strconv.Atoi(c.Args().Get(2))
+				owner := args.Get(0)
+				repo := args.Get(1)
+				number, err := strconv.Atoi(args.Get(2))
 				check(err)
-				issue := &github.IssueRequest{
-					Milestone: github.Int(c.Int("milestone")),
-					Title:     github.String(c.String("title")),
-					Body:      github.String(c.String("body")),
-					Labels:    stringSlicePointer(c.StringSlice("labels")),
-					Assignee:  github.String(c.String("assignee")),
-					State:     github.String(c.String("state")),
+				issue := &github.IssueRequest{}
+				check(decodeFile(c.String("body-file"), issue))
+				if c.IsSet("assignee") {
+					issue.Assignee = github.String(c.String("assignee"))
+				}
+				if c.IsSet("body") {
+					issue.Body = github.String(c.String("body"))
+				}
+				if c.IsSet("labels") {
+					issue.Labels = stringSlicePointer(c.StringSlice("labels"))
+				}
+				if c.IsSet("milestone") {
+					issue.Milestone = github.Int(c.Int("milestone"))
+				}
+				if c.IsSet("state") {
+					issue.State = github.String(c.String("state"))
+				}
+				if c.IsSet("title") {
+					issue.Title = github.String(c.String("title"))
 				}
 
 				result, res, err := app.gh.Issues.Edit(owner, repo, number, issue)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "Issue", result)
 
 			},
 		}, cli.Command{
@@ -285,33 +364,35 @@ and comments.  Default value is "created".`},
 				cli.IntFlag{Name: `page`, Usage: `For paginated result sets, page of results to retrieve.`},
 				cli.IntFlag{Name: `per-page`, Usage: `For paginated result sets, the number of results to include per page.`},
 				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
+				cli.IntFlag{Name: `limit`, Usage: `Stop after this many items, fetching the following pages as needed`},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the ListOptions, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 2 {
-					showHelp(c, "list-assignees", "list-assignees <owner> <repo>")
+				args := repoArgs(c, 0)
+				if len(args) < 2 {
+					showHelp(c, "list-assignees", "list-assignees [<owner> <repo>]")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				opt := &github.ListOptions{
-					Page:    c.Int("page"),
-					PerPage: c.Int("per-page"),
+				owner := args.Get(0)
+				repo := args.Get(1)
+				opt := &github.ListOptions{}
+				check(decodeFile(c.String("body-file"), opt))
+				if c.IsSet("page") {
+					opt.Page = c.Int("page")
+				}
+				if c.IsSet("per-page") {
+					opt.PerPage = c.Int("per-page")
 				}
 
-				var items []github.User
-
-				for {
-					page, res, err := app.gh.Issues.ListAssignees(owner, repo, opt)
-					checkResponse(res.Response, err)
-
-					items = append(items, page...)
-					if res.NextPage == 0 || !c.Bool("all") {
-						break
-					}
-					opt.Page = res.NextPage
+				if opt.PerPage == 0 {
+					opt.PerPage = app.profile.PerPage
 				}
 
-				fmt.Printf("%# v", pretty.Formatter(items))
+				paginate(c, "User", func(page int) (interface{}, *github.Response, error) {
+					opt := *opt
+					opt.Page = page
+					return app.gh.Issues.ListAssignees(owner, repo, &opt)
+				})
 			},
 		}, cli.Command{
 			Name:  "is-assignee",
@@ -321,17 +402,18 @@ and comments.  Default value is "created".`},
    GitHub API docs: http://developer.github.com/v3/issues/assignees/#check-assignee`,
 			Flags: []cli.Flag{},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 3 {
-					showHelp(c, "is-assignee", "is-assignee <owner> <repo> <user>")
+				args := repoArgs(c, 1)
+				if len(args) < 3 {
+					showHelp(c, "is-assignee", "is-assignee [<owner> <repo>] <user>")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				user := c.Args().Get(2)
+				owner := args.Get(0)
+				repo := args.Get(1)
+				user := args.Get(2)
 
 				result, res, err := app.gh.Issues.IsAssignee(owner, repo, user)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "bool", result)
 
 			},
 		}, cli.Command{
@@ -342,42 +424,52 @@ and comments.  Default value is "created".`},
 
    GitHub API docs: http://developer.github.com/v3/issues/comments/#list-comments-on-an-issue`,
 			Flags: []cli.Flag{
+				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
 				cli.StringFlag{Name: `direction`, Usage: `Direction in which to sort comments.  Possible values are: asc, desc.`},
-				cli.StringFlag{Name: `since`, Usage: `Since filters comments by time.`},
+				cli.IntFlag{Name: `limit`, Usage: `Stop after this many items, fetching the following pages as needed`},
 				cli.IntFlag{Name: `page`, Usage: `For paginated result sets, page of results to retrieve.`},
 				cli.IntFlag{Name: `per-page`, Usage: `For paginated result sets, the number of results to include per page.`},
-				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
+				cli.StringFlag{Name: `since`, Usage: `Since filters comments by time.`},
 				cli.StringFlag{Name: `sort`, Usage: `Sort specifies how to sort comments.  Possible values are: created, updated.`},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the IssueListCommentsOptions, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 3 {
-					showHelp(c, "list-comments", "list-comments <owner> <repo> <number>")
+				args := repoArgs(c, 1)
+				if len(args) < 3 {
+					showHelp(c, "list-comments", "list-comments [<owner> <repo>] <number>")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				number, err := strconv.Atoi(c.Args().Get(2))
+				owner := args.Get(0)
+				repo := args.Get(1)
+				number, err := strconv.Atoi(args.Get(2))
 				check(err)
-				opt := &github.IssueListCommentsOptions{
-					Sort:      c.String("sort"),
-					Direction: c.String("direction"),
-					Since:     now.MustParse(c.String("since")),
+				opt := &github.IssueListCommentsOptions{}
+				check(decodeFile(c.String("body-file"), opt))
+				if c.IsSet("direction") {
+					opt.Direction = c.String("direction")
+				}
+				if c.IsSet("since") {
+					opt.Since = timeFlag(c, "since")
+				}
+				if c.IsSet("sort") {
+					opt.Sort = c.String("sort")
+				}
+				if c.IsSet("page") {
+					opt.Page = c.Int("page")
+				}
+				if c.IsSet("per-page") {
+					opt.PerPage = c.Int("per-page")
 				}
 
-				var items []github.IssueComment
-
-				for {
-					page, res, err := app.gh.Issues.ListComments(owner, repo, number, opt)
-					checkResponse(res.Response, err)
-
-					items = append(items, page...)
-					if res.NextPage == 0 || !c.Bool("all") {
-						break
-					}
-					opt.Page = res.NextPage
+				if opt.PerPage == 0 {
+					opt.PerPage = app.profile.PerPage
 				}
 
-				fmt.Printf("%# v", pretty.Formatter(items))
+				paginate(c, "IssueComment", func(page int) (interface{}, *github.Response, error) {
+					opt := *opt
+					opt.Page = page
+					return app.gh.Issues.ListComments(owner, repo, number, &opt)
+				})
 			},
 		}, cli.Command{
 			Name:  "get-comment",
@@ -387,18 +479,19 @@ and comments.  Default value is "created".`},
    GitHub API docs: http://developer.github.com/v3/issues/comments/#get-a-single-comment`,
 			Flags: []cli.Flag{},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 3 {
-					showHelp(c, "get-comment", "get-comment <owner> <repo> <id>")
+				args := repoArgs(c, 1)
+				if len(args) < 3 {
+					showHelp(c, "get-comment", "get-comment [<owner> <repo>] <id>")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				id, err := strconv.Atoi(c.Args().Get(2))
+				owner := args.Get(0)
+				repo := args.Get(1)
+				id, err := strconv.Atoi(args.Get(2))
 				check(err)
 
 				result, res, err := app.gh.Issues.GetComment(owner, repo, id)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "IssueComment", result)
 
 			},
 		}, cli.Command{
@@ -409,56 +502,66 @@ and comments.  Default value is "created".`},
    GitHub API docs: http://developer.github.com/v3/issues/comments/#create-a-comment`,
 			Flags: []cli.Flag{
 				cli.StringFlag{Name: `body`, Usage: ``},
+				cli.StringFlag{Name: `created-at`, Usage: ``},
+				cli.IntFlag{Name: `id`, Usage: ``},
+				cli.StringFlag{Name: `updated-at`, Usage: ``},
 				cli.StringFlag{Name: `user-bio`, Usage: ``},
-				cli.BoolFlag{Name: `user-site-admin`, Usage: ``},
-				cli.StringFlag{Name: `user-location`, Usage: ``},
-				cli.IntFlag{Name: `user-disk-usage`, Usage: ``},
-				cli.BoolFlag{Name: `user-hireable`, Usage: ``},
-				cli.StringFlag{Name: `user-type`, Usage: ``},
-				cli.StringFlag{Name: `user-login`, Usage: ``},
+				cli.StringFlag{Name: `user-blog`, Usage: ``},
+				cli.IntFlag{Name: `user-collaborators`, Usage: ``},
 				cli.StringFlag{Name: `user-company`, Usage: ``},
+				cli.StringFlag{Name: `user-created-at`, Usage: ``},
+				cli.IntFlag{Name: `user-disk-usage`, Usage: ``},
 				cli.StringFlag{Name: `user-email`, Usage: ``},
+				cli.IntFlag{Name: `user-followers`, Usage: ``},
 				cli.IntFlag{Name: `user-following`, Usage: ``},
+				cli.StringFlag{Name: `user-gravatar-id`, Usage: ``},
+				cli.BoolFlag{Name: `user-hireable`, Usage: ``},
+				cli.IntFlag{Name: `user-id`, Usage: ``},
+				cli.StringFlag{Name: `user-location`, Usage: ``},
+				cli.StringFlag{Name: `user-login`, Usage: ``},
 				cli.StringFlag{Name: `user-name`, Usage: ``},
-				cli.IntFlag{Name: `user-public-gists`, Usage: ``},
-				cli.IntFlag{Name: `user-followers`, Usage: ``},
-				cli.IntFlag{Name: `user-private-gists`, Usage: ``},
-				cli.IntFlag{Name: `user-collaborators`, Usage: ``},
+				cli.IntFlag{Name: `user-owned-private-repos`, Usage: ``},
 				cli.IntFlag{Name: `user-plan-collaborators`, Usage: ``},
-				cli.IntFlag{Name: `user-plan-private-repos`, Usage: ``},
 				cli.StringFlag{Name: `user-plan-name`, Usage: ``},
+				cli.IntFlag{Name: `user-plan-private-repos`, Usage: ``},
 				cli.IntFlag{Name: `user-plan-space`, Usage: ``},
-				cli.StringFlag{Name: `user-gravatar-id`, Usage: ``},
-				cli.StringFlag{Name: `user-blog`, Usage: ``},
-				cli.StringFlag{Name: `user-created-at`, Usage: ``},
-				cli.IntFlag{Name: `user-total-private-repos`, Usage: ``},
-				cli.IntFlag{Name: `user-owned-private-repos`, Usage: ``},
-				cli.IntFlag{Name: `user-id`, Usage: ``},
+				cli.IntFlag{Name: `user-private-gists`, Usage: ``},
+				cli.IntFlag{Name: `user-public-gists`, Usage: ``},
 				cli.IntFlag{Name: `user-public-repos`, Usage: ``},
+				cli.BoolFlag{Name: `user-site-admin`, Usage: ``},
+				cli.IntFlag{Name: `user-total-private-repos`, Usage: ``},
+				cli.StringFlag{Name: `user-type`, Usage: ``},
 				cli.StringFlag{Name: `user-updated-at`, Usage: ``},
-				cli.StringFlag{Name: `created-at`, Usage: ``},
-				cli.StringFlag{Name: `updated-at`, Usage: ``},
-				cli.IntFlag{Name: `id`, Usage: ``},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the IssueComment, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 3 {
-					showHelp(c, "create-comment", "create-comment <owner> <repo> <number>")
+				args := repoArgs(c, 1)
+				if len(args) < 3 {
+					showHelp(c, "create-comment", "create-comment [<owner> <repo>] <number>")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				number, err := strconv.Atoi(c.Args().Get(2))
+				owner := args.Get(0)
+				repo := args.Get(1)
+				number, err := strconv.Atoi(args.Get(2))
 				check(err)
-				comment := &github.IssueComment{
-					CreatedAt: timePointer(now.MustParse(c.String("created-at"))),
-					UpdatedAt: timePointer(now.MustParse(c.String("updated-at"))),
-					ID:        github.Int(c.Int("id")),
-					Body:      github.String(c.String("body")),
+				comment := &github.IssueComment{}
+				check(decodeFile(c.String("body-file"), comment))
+				if c.IsSet("body") {
+					comment.Body = github.String(c.String("body"))
+				}
+				if c.IsSet("created-at") {
+					comment.CreatedAt = timePointer(timeFlag(c, "created-at"))
+				}
+				if c.IsSet("id") {
+					comment.ID = github.Int(c.Int("id"))
+				}
+				if c.IsSet("updated-at") {
+					comment.UpdatedAt = timePointer(timeFlag(c, "updated-at"))
 				}
 
 				result, res, err := app.gh.Issues.CreateComment(owner, repo, number, comment)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "IssueComment", result)
 
 			},
 		}, cli.Command{
@@ -468,57 +571,67 @@ and comments.  Default value is "created".`},
 
    GitHub API docs: http://developer.github.com/v3/issues/comments/#edit-a-comment`,
 			Flags: []cli.Flag{
-				cli.IntFlag{Name: `id`, Usage: ``},
 				cli.StringFlag{Name: `body`, Usage: ``},
-				cli.IntFlag{Name: `user-public-repos`, Usage: ``},
-				cli.StringFlag{Name: `user-updated-at`, Usage: ``},
-				cli.IntFlag{Name: `user-id`, Usage: ``},
-				cli.BoolFlag{Name: `user-site-admin`, Usage: ``},
+				cli.StringFlag{Name: `created-at`, Usage: ``},
+				cli.IntFlag{Name: `id`, Usage: ``},
+				cli.StringFlag{Name: `updated-at`, Usage: ``},
 				cli.StringFlag{Name: `user-bio`, Usage: ``},
-				cli.StringFlag{Name: `user-location`, Usage: ``},
-				cli.IntFlag{Name: `user-disk-usage`, Usage: ``},
-				cli.BoolFlag{Name: `user-hireable`, Usage: ``},
+				cli.StringFlag{Name: `user-blog`, Usage: ``},
+				cli.IntFlag{Name: `user-collaborators`, Usage: ``},
 				cli.StringFlag{Name: `user-company`, Usage: ``},
+				cli.StringFlag{Name: `user-created-at`, Usage: ``},
+				cli.IntFlag{Name: `user-disk-usage`, Usage: ``},
 				cli.StringFlag{Name: `user-email`, Usage: ``},
+				cli.IntFlag{Name: `user-followers`, Usage: ``},
 				cli.IntFlag{Name: `user-following`, Usage: ``},
-				cli.StringFlag{Name: `user-type`, Usage: ``},
+				cli.StringFlag{Name: `user-gravatar-id`, Usage: ``},
+				cli.BoolFlag{Name: `user-hireable`, Usage: ``},
+				cli.IntFlag{Name: `user-id`, Usage: ``},
+				cli.StringFlag{Name: `user-location`, Usage: ``},
 				cli.StringFlag{Name: `user-login`, Usage: ``},
-				cli.IntFlag{Name: `user-public-gists`, Usage: ``},
-				cli.IntFlag{Name: `user-followers`, Usage: ``},
-				cli.IntFlag{Name: `user-private-gists`, Usage: ``},
 				cli.StringFlag{Name: `user-name`, Usage: ``},
-				cli.StringFlag{Name: `user-blog`, Usage: ``},
-				cli.StringFlag{Name: `user-created-at`, Usage: ``},
-				cli.IntFlag{Name: `user-total-private-repos`, Usage: ``},
 				cli.IntFlag{Name: `user-owned-private-repos`, Usage: ``},
-				cli.IntFlag{Name: `user-collaborators`, Usage: ``},
-				cli.StringFlag{Name: `user-plan-name`, Usage: ``},
-				cli.IntFlag{Name: `user-plan-space`, Usage: ``},
 				cli.IntFlag{Name: `user-plan-collaborators`, Usage: ``},
+				cli.StringFlag{Name: `user-plan-name`, Usage: ``},
 				cli.IntFlag{Name: `user-plan-private-repos`, Usage: ``},
-				cli.StringFlag{Name: `user-gravatar-id`, Usage: ``},
-				cli.StringFlag{Name: `created-at`, Usage: ``},
-				cli.StringFlag{Name: `updated-at`, Usage: ``},
+				cli.IntFlag{Name: `user-plan-space`, Usage: ``},
+				cli.IntFlag{Name: `user-private-gists`, Usage: ``},
+				cli.IntFlag{Name: `user-public-gists`, Usage: ``},
+				cli.IntFlag{Name: `user-public-repos`, Usage: ``},
+				cli.BoolFlag{Name: `user-site-admin`, Usage: ``},
+				cli.IntFlag{Name: `user-total-private-repos`, Usage: ``},
+				cli.StringFlag{Name: `user-type`, Usage: ``},
+				cli.StringFlag{Name: `user-updated-at`, Usage: ``},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the IssueComment, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 3 {
-					showHelp(c, "edit-comment", "edit-comment <owner> <repo> <id>")
+				args := repoArgs(c, 1)
+				if len(args) < 3 {
+					showHelp(c, "edit-comment", "edit-comment [<owner> <repo>] <id>")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				id, err := strconv.Atoi(c.Args().Get(2))
+				owner := args.Get(0)
+				repo := args.Get(1)
+				id, err := strconv.Atoi(args.Get(2))
 				check(err)
-				comment := &github.IssueComment{
-					ID:        github.Int(c.Int("id")),
-					Body:      github.String(c.String("body")),
-					CreatedAt: timePointer(now.MustParse(c.String("created-at"))),
-					UpdatedAt: timePointer(now.MustParse(c.String("updated-at"))),
+				comment := &github.IssueComment{}
+				check(decodeFile(c.String("body-file"), comment))
+				if c.IsSet("body") {
+					comment.Body = github.String(c.String("body"))
+				}
+				if c.IsSet("created-at") {
+					comment.CreatedAt = timePointer(timeFlag(c, "created-at"))
+				}
+				if c.IsSet("id") {
+					comment.ID = github.Int(c.Int("id"))
+				}
+				if c.IsSet("updated-at") {
+					comment.UpdatedAt = timePointer(timeFlag(c, "updated-at"))
 				}
 
 				result, res, err := app.gh.Issues.EditComment(owner, repo, id, comment)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "IssueComment", result)
 
 			},
 		}, cli.Command{
@@ -527,19 +640,24 @@ and comments.  Default value is "created".`},
 			Description: `delete-comment deletes an issue comment.
 
    GitHub API docs: http://developer.github.com/v3/issues/comments/#delete-a-comment`,
-			Flags: []cli.Flag{},
+			Flags: []cli.Flag{
+				cli.BoolFlag{Name: `yes`, Usage: `Don't ask for confirmation`},
+			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 3 {
-					showHelp(c, "delete-comment", "delete-comment <owner> <repo> <id>")
+				args := repoArgs(c, 1)
+				if len(args) < 3 {
+					showHelp(c, "delete-comment", "delete-comment [<owner> <repo>] <id>")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				id, err := strconv.Atoi(c.Args().Get(2))
+				owner := args.Get(0)
+				repo := args.Get(1)
+				id, err := strconv.Atoi(args.Get(2))
 				check(err)
 
+				confirm(c, "delete-comment", repo)
+
 				res, err := app.gh.Issues.DeleteComment(owner, repo, id)
-				checkResponse(res.Response, err)
+				checkResponse(res, err)
 
 			},
 		}, cli.Command{
@@ -552,35 +670,37 @@ and comments.  Default value is "created".`},
 				cli.IntFlag{Name: `page`, Usage: `For paginated result sets, page of results to retrieve.`},
 				cli.IntFlag{Name: `per-page`, Usage: `For paginated result sets, the number of results to include per page.`},
 				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
+				cli.IntFlag{Name: `limit`, Usage: `Stop after this many items, fetching the following pages as needed`},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the ListOptions, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 3 {
-					showHelp(c, "list-issue-events", "list-issue-events <owner> <repo> <number>")
+				args := repoArgs(c, 1)
+				if len(args) < 3 {
+					showHelp(c, "list-issue-events", "list-issue-events [<owner> <repo>] <number>")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				number, err := strconv.Atoi(c.Args().Get(2))
+				owner := args.Get(0)
+				repo := args.Get(1)
+				number, err := strconv.Atoi(args.Get(2))
 				check(err)
-				opt := &github.ListOptions{
-					Page:    c.Int("page"),
-					PerPage: c.Int("per-page"),
+				opt := &github.ListOptions{}
+				check(decodeFile(c.String("body-file"), opt))
+				if c.IsSet("page") {
+					opt.Page = c.Int("page")
+				}
+				if c.IsSet("per-page") {
+					opt.PerPage = c.Int("per-page")
 				}
 
-				var items []github.IssueEvent
-
-				for {
-					page, res, err := app.gh.Issues.ListIssueEvents(owner, repo, number, opt)
-					checkResponse(res.Response, err)
-
-					items = append(items, page...)
-					if res.NextPage == 0 || !c.Bool("all") {
-						break
-					}
-					opt.Page = res.NextPage
+				if opt.PerPage == 0 {
+					opt.PerPage = app.profile.PerPage
 				}
 
-				fmt.Printf("%# v", pretty.Formatter(items))
+				paginate(c, "IssueEvent", func(page int) (interface{}, *github.Response, error) {
+					opt := *opt
+					opt.Page = page
+					return app.gh.Issues.ListIssueEvents(owner, repo, number, &opt)
+				})
 			},
 		}, cli.Command{
 			Name:  "list-repository-events",
@@ -592,33 +712,35 @@ and comments.  Default value is "created".`},
 				cli.IntFlag{Name: `page`, Usage: `For paginated result sets, page of results to retrieve.`},
 				cli.IntFlag{Name: `per-page`, Usage: `For paginated result sets, the number of results to include per page.`},
 				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
+				cli.IntFlag{Name: `limit`, Usage: `Stop after this many items, fetching the following pages as needed`},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the ListOptions, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 2 {
-					showHelp(c, "list-repository-events", "list-repository-events <owner> <repo>")
+				args := repoArgs(c, 0)
+				if len(args) < 2 {
+					showHelp(c, "list-repository-events", "list-repository-events [<owner> <repo>]")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				opt := &github.ListOptions{
-					Page:    c.Int("page"),
-					PerPage: c.Int("per-page"),
+				owner := args.Get(0)
+				repo := args.Get(1)
+				opt := &github.ListOptions{}
+				check(decodeFile(c.String("body-file"), opt))
+				if c.IsSet("page") {
+					opt.Page = c.Int("page")
+				}
+				if c.IsSet("per-page") {
+					opt.PerPage = c.Int("per-page")
 				}
 
-				var items []github.IssueEvent
-
-				for {
-					page, res, err := app.gh.Issues.ListRepositoryEvents(owner, repo, opt)
-					checkResponse(res.Response, err)
-
-					items = append(items, page...)
-					if res.NextPage == 0 || !c.Bool("all") {
-						break
-					}
-					opt.Page = res.NextPage
+				if opt.PerPage == 0 {
+					opt.PerPage = app.profile.PerPage
 				}
 
-				fmt.Printf("%# v", pretty.Formatter(items))
+				paginate(c, "IssueEvent", func(page int) (interface{}, *github.Response, error) {
+					opt := *opt
+					opt.Page = page
+					return app.gh.Issues.ListRepositoryEvents(owner, repo, &opt)
+				})
 			},
 		}, cli.Command{
 			Name:  "get-event",
@@ -628,18 +750,19 @@ and comments.  Default value is "created".`},
    GitHub API docs: https://developer.github.com/v3/issues/events/#get-a-single-event`,
 			Flags: []cli.Flag{},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 3 {
-					showHelp(c, "get-event", "get-event <owner> <repo> <id>")
+				args := repoArgs(c, 1)
+				if len(args) < 3 {
+					showHelp(c, "get-event", "get-event [<owner> <repo>] <id>")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				id, err := strconv.Atoi(c.Args().Get(2))
+				owner := args.Get(0)
+				repo := args.Get(1)
+				id, err := strconv.Atoi(args.Get(2))
 				check(err)
 
 				result, res, err := app.gh.Issues.GetEvent(owner, repo, id)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "IssueEvent", result)
 
 			},
 		}, cli.Command{
@@ -652,33 +775,35 @@ and comments.  Default value is "created".`},
 				cli.IntFlag{Name: `page`, Usage: `For paginated result sets, page of results to retrieve.`},
 				cli.IntFlag{Name: `per-page`, Usage: `For paginated result sets, the number of results to include per page.`},
 				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
+				cli.IntFlag{Name: `limit`, Usage: `Stop after this many items, fetching the following pages as needed`},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the ListOptions, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 2 {
-					showHelp(c, "list-labels", "list-labels <owner> <repo>")
+				args := repoArgs(c, 0)
+				if len(args) < 2 {
+					showHelp(c, "list-labels", "list-labels [<owner> <repo>]")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				opt := &github.ListOptions{
-					Page:    c.Int("page"),
-					PerPage: c.Int("per-page"),
+				owner := args.Get(0)
+				repo := args.Get(1)
+				opt := &github.ListOptions{}
+				check(decodeFile(c.String("body-file"), opt))
+				if c.IsSet("page") {
+					opt.Page = c.Int("page")
+				}
+				if c.IsSet("per-page") {
+					opt.PerPage = c.Int("per-page")
 				}
 
-				var items []github.Label
-
-				for {
-					page, res, err := app.gh.Issues.ListLabels(owner, repo, opt)
-					checkResponse(res.Response, err)
-
-					items = append(items, page...)
-					if res.NextPage == 0 || !c.Bool("all") {
-						break
-					}
-					opt.Page = res.NextPage
+				if opt.PerPage == 0 {
+					opt.PerPage = app.profile.PerPage
 				}
 
-				fmt.Printf("%# v", pretty.Formatter(items))
+				paginate(c, "Label", func(page int) (interface{}, *github.Response, error) {
+					opt := *opt
+					opt.Page = page
+					return app.gh.Issues.ListLabels(owner, repo, &opt)
+				})
 			},
 		}, cli.Command{
 			Name:  "get-label",
@@ -688,17 +813,18 @@ and comments.  Default value is "created".`},
    GitHub API docs: http://developer.github.com/v3/issues/labels/#get-a-single-label`,
 			Flags: []cli.Flag{},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 3 {
-					showHelp(c, "get-label", "get-label <owner> <repo> <name>")
+				args := repoArgs(c, 1)
+				if len(args) < 3 {
+					showHelp(c, "get-label", "get-label [<owner> <repo>] <name>")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				name := c.Args().Get(2)
+				owner := args.Get(0)
+				repo := args.Get(1)
+				name := args.Get(2)
 
 				result, res, err := app.gh.Issues.GetLabel(owner, repo, name)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "Label", result)
 
 			},
 		}, cli.Command{
@@ -708,24 +834,30 @@ and comments.  Default value is "created".`},
 
    GitHub API docs: http://developer.github.com/v3/issues/labels/#create-a-label`,
 			Flags: []cli.Flag{
-				cli.StringFlag{Name: `name`, Usage: ``},
 				cli.StringFlag{Name: `color`, Usage: ``},
+				cli.StringFlag{Name: `name`, Usage: ``},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the Label, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 2 {
-					showHelp(c, "create-label", "create-label <owner> <repo>")
+				args := repoArgs(c, 0)
+				if len(args) < 2 {
+					showHelp(c, "create-label", "create-label [<owner> <repo>]")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				label := &github.Label{
-					Name:  github.String(c.String("name")),
-					Color: github.String(c.String("color")),
+				owner := args.Get(0)
+				repo := args.Get(1)
+				label := &github.Label{}
+				check(decodeFile(c.String("body-file"), label))
+				if c.IsSet("color") {
+					label.Color = github.String(c.String("color"))
+				}
+				if c.IsSet("name") {
+					label.Name = github.String(c.String("name"))
 				}
 
 				result, res, err := app.gh.Issues.CreateLabel(owner, repo, label)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "Label", result)
 
 			},
 		}, cli.Command{
@@ -735,25 +867,31 @@ and comments.  Default value is "created".`},
 
    GitHub API docs: http://developer.github.com/v3/issues/labels/#update-a-label`,
 			Flags: []cli.Flag{
-				cli.StringFlag{Name: `name`, Usage: ``},
 				cli.StringFlag{Name: `color`, Usage: ``},
+				cli.StringFlag{Name: `name`, Usage: ``},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the Label, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 3 {
-					showHelp(c, "edit-label", "edit-label <owner> <repo> <name>")
+				args := repoArgs(c, 1)
+				if len(args) < 3 {
+					showHelp(c, "edit-label", "edit-label [<owner> <repo>] <name>")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				name := c.Args().Get(2)
-				label := &github.Label{
-					Name:  github.String(c.String("name")),
-					Color: github.String(c.String("color")),
+				owner := args.Get(0)
+				repo := args.Get(1)
+				name := args.Get(2)
+				label := &github.Label{}
+				check(decodeFile(c.String("body-file"), label))
+				if c.IsSet("color") {
+					label.Color = github.String(c.String("color"))
+				}
+				if c.IsSet("name") {
+					label.Name = github.String(c.String("name"))
 				}
 
 				result, res, err := app.gh.Issues.EditLabel(owner, repo, name, label)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "Label", result)
 
 			},
 		}, cli.Command{
@@ -762,18 +900,23 @@ and comments.  Default value is "created".`},
 			Description: `delete-label deletes a label.
 
    GitHub API docs: http://developer.github.com/v3/issues/labels/#delete-a-label`,
-			Flags: []cli.Flag{},
+			Flags: []cli.Flag{
+				cli.BoolFlag{Name: `yes`, Usage: `Don't ask for confirmation`},
+			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 3 {
-					showHelp(c, "delete-label", "delete-label <owner> <repo> <name>")
+				args := repoArgs(c, 1)
+				if len(args) < 3 {
+					showHelp(c, "delete-label", "delete-label [<owner> <repo>] <name>")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				name := c.Args().Get(2)
+				owner := args.Get(0)
+				repo := args.Get(1)
+				name := args.Get(2)
+
+				confirm(c, "delete-label", repo)
 
 				res, err := app.gh.Issues.DeleteLabel(owner, repo, name)
-				checkResponse(res.Response, err)
+				checkResponse(res, err)
 
 			},
 		}, cli.Command{
@@ -786,35 +929,37 @@ and comments.  Default value is "created".`},
 				cli.IntFlag{Name: `page`, Usage: `For paginated result sets, page of results to retrieve.`},
 				cli.IntFlag{Name: `per-page`, Usage: `For paginated result sets, the number of results to include per page.`},
 				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
+				cli.IntFlag{Name: `limit`, Usage: `Stop after this many items, fetching the following pages as needed`},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the ListOptions, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 3 {
-					showHelp(c, "list-labels-by-issue", "list-labels-by-issue <owner> <repo> <number>")
+				args := repoArgs(c, 1)
+				if len(args) < 3 {
+					showHelp(c, "list-labels-by-issue", "list-labels-by-issue [<owner> <repo>] <number>")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				number, err := strconv.Atoi(c.Args().Get(2))
+				owner := args.Get(0)
+				repo := args.Get(1)
+				number, err := strconv.Atoi(args.Get(2))
 				check(err)
-				opt := &github.ListOptions{
-					Page:    c.Int("page"),
-					PerPage: c.Int("per-page"),
+				opt := &github.ListOptions{}
+				check(decodeFile(c.String("body-file"), opt))
+				if c.IsSet("page") {
+					opt.Page = c.Int("page")
+				}
+				if c.IsSet("per-page") {
+					opt.PerPage = c.Int("per-page")
 				}
 
-				var items []github.Label
-
-				for {
-					page, res, err := app.gh.Issues.ListLabelsByIssue(owner, repo, number, opt)
-					checkResponse(res.Response, err)
-
-					items = append(items, page...)
-					if res.NextPage == 0 || !c.Bool("all") {
-						break
-					}
-					opt.Page = res.NextPage
+				if opt.PerPage == 0 {
+					opt.PerPage = app.profile.PerPage
 				}
 
-				fmt.Printf("%# v", pretty.Formatter(items))
+				paginate(c, "Label", func(page int) (interface{}, *github.Response, error) {
+					opt := *opt
+					opt.Page = page
+					return app.gh.Issues.ListLabelsByIssue(owner, repo, number, &opt)
+				})
 			},
 		}, cli.Command{
 			Name:  "add-labels-to-issue",
@@ -826,19 +971,20 @@ and comments.  Default value is "created".`},
 				cli.StringSliceFlag{Name: `labels`, Usage: ``},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 3 {
-					showHelp(c, "add-labels-to-issue", "add-labels-to-issue <owner> <repo> <number>")
+				args := repoArgs(c, 1)
+				if len(args) < 3 {
+					showHelp(c, "add-labels-to-issue", "add-labels-to-issue [<owner> <repo>] <number>")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				number, err := strconv.Atoi(c.Args().Get(2))
+				owner := args.Get(0)
+				repo := args.Get(1)
+				number, err := strconv.Atoi(args.Get(2))
 				check(err)
 				labels := c.StringSlice("labels")
 
 				result, res, err := app.gh.Issues.AddLabelsToIssue(owner, repo, number, labels)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "Label", result)
 
 			},
 		}, cli.Command{
@@ -847,20 +993,25 @@ and comments.  Default value is "created".`},
 			Description: `remove-label-for-issue removes a label for an issue.
 
    GitHub API docs: http://developer.github.com/v3/issues/labels/#remove-a-label-from-an-issue`,
-			Flags: []cli.Flag{},
+			Flags: []cli.Flag{
+				cli.BoolFlag{Name: `yes`, Usage: `Don't ask for confirmation`},
+			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 4 {
-					showHelp(c, "remove-label-for-issue", "remove-label-for-issue <owner> <repo> <number> <label>")
+				args := repoArgs(c, 2)
+				if len(args) < 4 {
+					showHelp(c, "remove-label-for-issue", "remove-label-for-issue [<owner> <repo>] <number> <label>")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				number, err := strconv.Atoi(c.Args().Get(2))
+				owner := args.Get(0)
+				repo := args.Get(1)
+				number, err := strconv.Atoi(args.Get(2))
 				check(err)
-				label := c.Args().Get(3)
+				label := args.Get(3)
+
+				confirm(c, "remove-label-for-issue", repo)
 
 				res, err := app.gh.Issues.RemoveLabelForIssue(owner, repo, number, label)
-				checkResponse(res.Response, err)
+				checkResponse(res, err)
 
 			},
 		}, cli.Command{
@@ -873,19 +1024,20 @@ and comments.  Default value is "created".`},
 				cli.StringSliceFlag{Name: `labels`, Usage: ``},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 3 {
-					showHelp(c, "replace-labels-for-issue", "replace-labels-for-issue <owner> <repo> <number>")
+				args := repoArgs(c, 1)
+				if len(args) < 3 {
+					showHelp(c, "replace-labels-for-issue", "replace-labels-for-issue [<owner> <repo>] <number>")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				number, err := strconv.Atoi(c.Args().Get(2))
+				owner := args.Get(0)
+				repo := args.Get(1)
+				number, err := strconv.Atoi(args.Get(2))
 				check(err)
 				labels := c.StringSlice("labels")
 
 				result, res, err := app.gh.Issues.ReplaceLabelsForIssue(owner, repo, number, labels)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "Label", result)
 
 			},
 		}, cli.Command{
@@ -894,19 +1046,24 @@ and comments.  Default value is "created".`},
 			Description: `remove-labels-for-issue removes all labels for an issue.
 
    GitHub API docs: http://developer.github.com/v3/issues/labels/#remove-all-labels-from-an-issue`,
-			Flags: []cli.Flag{},
+			Flags: []cli.Flag{
+				cli.BoolFlag{Name: `yes`, Usage: `Don't ask for confirmation`},
+			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 3 {
-					showHelp(c, "remove-labels-for-issue", "remove-labels-for-issue <owner> <repo> <number>")
+				args := repoArgs(c, 1)
+				if len(args) < 3 {
+					showHelp(c, "remove-labels-for-issue", "remove-labels-for-issue [<owner> <repo>] <number>")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				number, err := strconv.Atoi(c.Args().Get(2))
+				owner := args.Get(0)
+				repo := args.Get(1)
+				number, err := strconv.Atoi(args.Get(2))
 				check(err)
 
+				confirm(c, "remove-labels-for-issue", repo)
+
 				res, err := app.gh.Issues.RemoveLabelsForIssue(owner, repo, number)
-				checkResponse(res.Response, err)
+				checkResponse(res, err)
 
 			},
 		}, cli.Command{
@@ -919,35 +1076,37 @@ and comments.  Default value is "created".`},
 				cli.IntFlag{Name: `page`, Usage: `For paginated result sets, page of results to retrieve.`},
 				cli.IntFlag{Name: `per-page`, Usage: `For paginated result sets, the number of results to include per page.`},
 				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
+				cli.IntFlag{Name: `limit`, Usage: `Stop after this many items, fetching the following pages as needed`},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the ListOptions, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 3 {
-					showHelp(c, "list-labels-for-milestone", "list-labels-for-milestone <owner> <repo> <number>")
+				args := repoArgs(c, 1)
+				if len(args) < 3 {
+					showHelp(c, "list-labels-for-milestone", "list-labels-for-milestone [<owner> <repo>] <number>")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				number, err := strconv.Atoi(c.Args().Get(2))
+				owner := args.Get(0)
+				repo := args.Get(1)
+				number, err := strconv.Atoi(args.Get(2))
 				check(err)
-				opt := &github.ListOptions{
-					PerPage: c.Int("per-page"),
-					Page:    c.Int("page"),
+				opt := &github.ListOptions{}
+				check(decodeFile(c.String("body-file"), opt))
+				if c.IsSet("page") {
+					opt.Page = c.Int("page")
+				}
+				if c.IsSet("per-page") {
+					opt.PerPage = c.Int("per-page")
 				}
 
-				var items []github.Label
-
-				for {
-					page, res, err := app.gh.Issues.ListLabelsForMilestone(owner, repo, number, opt)
-					checkResponse(res.Response, err)
-
-					items = append(items, page...)
-					if res.NextPage == 0 || !c.Bool("all") {
-						break
-					}
-					opt.Page = res.NextPage
+				if opt.PerPage == 0 {
+					opt.PerPage = app.profile.PerPage
 				}
 
-				fmt.Printf("%# v", pretty.Formatter(items))
+				paginate(c, "Label", func(page int) (interface{}, *github.Response, error) {
+					opt := *opt
+					opt.Page = page
+					return app.gh.Issues.ListLabelsForMilestone(owner, repo, number, &opt)
+				})
 			},
 		}, cli.Command{
 			Name:  "list-milestones",
@@ -956,29 +1115,37 @@ and comments.  Default value is "created".`},
 
    GitHub API docs: https://developer.github.com/v3/issues/milestones/#list-milestones-for-a-repository`,
 			Flags: []cli.Flag{
-				cli.StringFlag{Name: `state`, Usage: `State filters milestones based on their state. Possible values are:
-open, closed. Default is "open".`},
-				cli.StringFlag{Name: `sort`, Usage: `Sort specifies how to sort milestones. Possible values are: due_date, completeness.
-Default value is "due_date".`},
 				cli.StringFlag{Name: `direction`, Usage: `Direction in which to sort milestones. Possible values are: asc, desc.
 Default is "asc".`},
+				cli.StringFlag{Name: `sort`, Usage: `Sort specifies how to sort milestones. Possible values are: due_date, completeness.
+Default value is "due_date".`},
+				cli.StringFlag{Name: `state`, Usage: `State filters milestones based on their state. Possible values are:
+open, closed. Default is "open".`},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the MilestoneListOptions, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 2 {
-					showHelp(c, "list-milestones", "list-milestones <owner> <repo>")
+				args := repoArgs(c, 0)
+				if len(args) < 2 {
+					showHelp(c, "list-milestones", "list-milestones [<owner> <repo>]")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				opt := &github.MilestoneListOptions{
-					Direction: c.String("direction"),
-					State:     c.String("state"),
-					Sort:      c.String("sort"),
+				owner := args.Get(0)
+				repo := args.Get(1)
+				opt := &github.MilestoneListOptions{}
+				check(decodeFile(c.String("body-file"), opt))
+				if c.IsSet("direction") {
+					opt.Direction = c.String("direction")
+				}
+				if c.IsSet("sort") {
+					opt.Sort = c.String("sort")
+				}
+				if c.IsSet("state") {
+					opt.State = c.String("state")
 				}
 
 				result, res, err := app.gh.Issues.ListMilestones(owner, repo, opt)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "Milestone", result)
 
 			},
 		}, cli.Command{
@@ -989,18 +1156,19 @@ Default is "asc".`},
    GitHub API docs: https://developer.github.com/v3/issues/milestones/#get-a-single-milestone`,
 			Flags: []cli.Flag{},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 3 {
-					showHelp(c, "get-milestone", "get-milestone <owner> <repo> <number>")
+				args := repoArgs(c, 1)
+				if len(args) < 3 {
+					showHelp(c, "get-milestone", "get-milestone [<owner> <repo>] <number>")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				number, err := strconv.Atoi(c.Args().Get(2))
+				owner := args.Get(0)
+				repo := args.Get(1)
+				number, err := strconv.Atoi(args.Get(2))
 				check(err)
 
 				result, res, err := app.gh.Issues.GetMilestone(owner, repo, number)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "Milestone", result)
 
 			},
 		}, cli.Command{
@@ -1010,65 +1178,85 @@ Default is "asc".`},
 
    GitHub API docs: https://developer.github.com/v3/issues/milestones/#create-a-milestone`,
 			Flags: []cli.Flag{
-				cli.StringFlag{Name: `due-on`, Usage: ``},
-				cli.IntFlag{Name: `number`, Usage: ``},
-				cli.StringFlag{Name: `state`, Usage: ``},
-				cli.IntFlag{Name: `creator-private-gists`, Usage: ``},
-				cli.StringFlag{Name: `creator-name`, Usage: ``},
-				cli.IntFlag{Name: `creator-public-gists`, Usage: ``},
+				cli.IntFlag{Name: `closed-issues`, Usage: ``},
+				cli.StringFlag{Name: `created-at`, Usage: ``},
+				cli.StringFlag{Name: `creator-bio`, Usage: ``},
+				cli.StringFlag{Name: `creator-blog`, Usage: ``},
+				cli.IntFlag{Name: `creator-collaborators`, Usage: ``},
+				cli.StringFlag{Name: `creator-company`, Usage: ``},
+				cli.StringFlag{Name: `creator-created-at`, Usage: ``},
+				cli.IntFlag{Name: `creator-disk-usage`, Usage: ``},
+				cli.StringFlag{Name: `creator-email`, Usage: ``},
 				cli.IntFlag{Name: `creator-followers`, Usage: ``},
-				cli.IntFlag{Name: `creator-total-private-repos`, Usage: ``},
+				cli.IntFlag{Name: `creator-following`, Usage: ``},
+				cli.StringFlag{Name: `creator-gravatar-id`, Usage: ``},
+				cli.BoolFlag{Name: `creator-hireable`, Usage: ``},
+				cli.IntFlag{Name: `creator-id`, Usage: ``},
+				cli.StringFlag{Name: `creator-location`, Usage: ``},
+				cli.StringFlag{Name: `creator-login`, Usage: ``},
+				cli.StringFlag{Name: `creator-name`, Usage: ``},
 				cli.IntFlag{Name: `creator-owned-private-repos`, Usage: ``},
-				cli.IntFlag{Name: `creator-collaborators`, Usage: ``},
 				cli.IntFlag{Name: `creator-plan-collaborators`, Usage: ``},
-				cli.IntFlag{Name: `creator-plan-private-repos`, Usage: ``},
 				cli.StringFlag{Name: `creator-plan-name`, Usage: ``},
+				cli.IntFlag{Name: `creator-plan-private-repos`, Usage: ``},
 				cli.IntFlag{Name: `creator-plan-space`, Usage: ``},
-				cli.StringFlag{Name: `creator-gravatar-id`, Usage: ``},
-				cli.StringFlag{Name: `creator-blog`, Usage: ``},
-				cli.StringFlag{Name: `creator-created-at`, Usage: ``},
-				cli.IntFlag{Name: `creator-id`, Usage: ``},
+				cli.IntFlag{Name: `creator-private-gists`, Usage: ``},
+				cli.IntFlag{Name: `creator-public-gists`, Usage: ``},
 				cli.IntFlag{Name: `creator-public-repos`, Usage: ``},
-				cli.StringFlag{Name: `creator-updated-at`, Usage: ``},
-				cli.StringFlag{Name: `creator-bio`, Usage: ``},
 				cli.BoolFlag{Name: `creator-site-admin`, Usage: ``},
-				cli.StringFlag{Name: `creator-location`, Usage: ``},
-				cli.IntFlag{Name: `creator-disk-usage`, Usage: ``},
-				cli.BoolFlag{Name: `creator-hireable`, Usage: ``},
-				cli.StringFlag{Name: `creator-email`, Usage: ``},
-				cli.IntFlag{Name: `creator-following`, Usage: ``},
+				cli.IntFlag{Name: `creator-total-private-repos`, Usage: ``},
 				cli.StringFlag{Name: `creator-type`, Usage: ``},
-				cli.StringFlag{Name: `creator-login`, Usage: ``},
-				cli.StringFlag{Name: `creator-company`, Usage: ``},
-				cli.IntFlag{Name: `closed-issues`, Usage: ``},
-				cli.StringFlag{Name: `created-at`, Usage: ``},
-				cli.StringFlag{Name: `title`, Usage: ``},
+				cli.StringFlag{Name: `creator-updated-at`, Usage: ``},
 				cli.StringFlag{Name: `description`, Usage: ``},
+				cli.StringFlag{Name: `due-on`, Usage: ``},
+				cli.IntFlag{Name: `number`, Usage: ``},
 				cli.IntFlag{Name: `open-issues`, Usage: ``},
+				cli.StringFlag{Name: `state`, Usage: ``},
+				cli.StringFlag{Name: `title`, Usage: ``},
 				cli.StringFlag{Name: `updated-at`, Usage: ``},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the Milestone, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 2 {
-					showHelp(c, "create-milestone", "create-milestone <owner> <repo>")
+				args := repoArgs(c, 0)
+				if len(args) < 2 {
+					showHelp(c, "create-milestone", "create-milestone [<owner> <repo>]")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				milestone := &github.Milestone{
-					DueOn:        timePointer(now.MustParse(c.String("due-on"))),
-					Number:       github.Int(c.Int("number")),
-					State:        github.String(c.String("state")),
-					ClosedIssues: github.Int(c.Int("closed-issues")),
-					CreatedAt:    timePointer(now.MustParse(c.String("created-at"))),
-					Title:        github.String(c.String("title")),
-					Description:  github.String(c.String("description")),
-					OpenIssues:   github.Int(c.Int("open-issues")),
-					UpdatedAt:    timePointer(now.MustParse(c.String("updated-at"))),
+				owner := args.Get(0)
+				repo := args.Get(1)
+				milestone := &github.Milestone{}
+				check(decodeFile(c.String("body-file"), milestone))
+				if c.IsSet("closed-issues") {
+					milestone.ClosedIssues = github.Int(c.Int("closed-issues"))
+				}
+				if c.IsSet("created-at") {
+					milestone.CreatedAt = timePointer(timeFlag(c, "created-at"))
+				}
+				if c.IsSet("description") {
+					milestone.Description = github.String(c.String("description"))
+				}
+				if c.IsSet("due-on") {
+					milestone.DueOn = timePointer(timeFlag(c, "due-on"))
+				}
+				if c.IsSet("number") {
+					milestone.Number = github.Int(c.Int("number"))
+				}
+				if c.IsSet("open-issues") {
+					milestone.OpenIssues = github.Int(c.Int("open-issues"))
+				}
+				if c.IsSet("state") {
+					milestone.State = github.String(c.String("state"))
+				}
+				if c.IsSet("title") {
+					milestone.Title = github.String(c.String("title"))
+				}
+				if c.IsSet("updated-at") {
+					milestone.UpdatedAt = timePointer(timeFlag(c, "updated-at"))
 				}
 
 				result, res, err := app.gh.Issues.CreateMilestone(owner, repo, milestone)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "Milestone", result)
 
 			},
 		}, cli.Command{
@@ -1080,65 +1268,85 @@ Default is "asc".`},
 			Flags: []cli.Flag{
 				cli.IntFlag{Name: `closed-issues`, Usage: ``},
 				cli.StringFlag{Name: `created-at`, Usage: ``},
-				cli.StringFlag{Name: `due-on`, Usage: ``},
-				cli.IntFlag{Name: `number`, Usage: ``},
-				cli.StringFlag{Name: `state`, Usage: ``},
-				cli.StringFlag{Name: `creator-location`, Usage: ``},
-				cli.IntFlag{Name: `creator-disk-usage`, Usage: ``},
-				cli.BoolFlag{Name: `creator-hireable`, Usage: ``},
+				cli.StringFlag{Name: `creator-bio`, Usage: ``},
+				cli.StringFlag{Name: `creator-blog`, Usage: ``},
+				cli.IntFlag{Name: `creator-collaborators`, Usage: ``},
 				cli.StringFlag{Name: `creator-company`, Usage: ``},
+				cli.StringFlag{Name: `creator-created-at`, Usage: ``},
+				cli.IntFlag{Name: `creator-disk-usage`, Usage: ``},
 				cli.StringFlag{Name: `creator-email`, Usage: ``},
+				cli.IntFlag{Name: `creator-followers`, Usage: ``},
 				cli.IntFlag{Name: `creator-following`, Usage: ``},
-				cli.StringFlag{Name: `creator-type`, Usage: ``},
+				cli.StringFlag{Name: `creator-gravatar-id`, Usage: ``},
+				cli.BoolFlag{Name: `creator-hireable`, Usage: ``},
+				cli.IntFlag{Name: `creator-id`, Usage: ``},
+				cli.StringFlag{Name: `creator-location`, Usage: ``},
 				cli.StringFlag{Name: `creator-login`, Usage: ``},
-				cli.IntFlag{Name: `creator-public-gists`, Usage: ``},
-				cli.IntFlag{Name: `creator-followers`, Usage: ``},
-				cli.IntFlag{Name: `creator-private-gists`, Usage: ``},
 				cli.StringFlag{Name: `creator-name`, Usage: ``},
-				cli.StringFlag{Name: `creator-blog`, Usage: ``},
-				cli.StringFlag{Name: `creator-created-at`, Usage: ``},
-				cli.IntFlag{Name: `creator-total-private-repos`, Usage: ``},
 				cli.IntFlag{Name: `creator-owned-private-repos`, Usage: ``},
-				cli.IntFlag{Name: `creator-collaborators`, Usage: ``},
-				cli.StringFlag{Name: `creator-plan-name`, Usage: ``},
-				cli.IntFlag{Name: `creator-plan-space`, Usage: ``},
 				cli.IntFlag{Name: `creator-plan-collaborators`, Usage: ``},
+				cli.StringFlag{Name: `creator-plan-name`, Usage: ``},
 				cli.IntFlag{Name: `creator-plan-private-repos`, Usage: ``},
-				cli.StringFlag{Name: `creator-gravatar-id`, Usage: ``},
+				cli.IntFlag{Name: `creator-plan-space`, Usage: ``},
+				cli.IntFlag{Name: `creator-private-gists`, Usage: ``},
+				cli.IntFlag{Name: `creator-public-gists`, Usage: ``},
 				cli.IntFlag{Name: `creator-public-repos`, Usage: ``},
-				cli.StringFlag{Name: `creator-updated-at`, Usage: ``},
-				cli.IntFlag{Name: `creator-id`, Usage: ``},
 				cli.BoolFlag{Name: `creator-site-admin`, Usage: ``},
-				cli.StringFlag{Name: `creator-bio`, Usage: ``},
+				cli.IntFlag{Name: `creator-total-private-repos`, Usage: ``},
+				cli.StringFlag{Name: `creator-type`, Usage: ``},
+				cli.StringFlag{Name: `creator-updated-at`, Usage: ``},
+				cli.StringFlag{Name: `description`, Usage: ``},
+				cli.StringFlag{Name: `due-on`, Usage: ``},
+				cli.IntFlag{Name: `number`, Usage: ``},
 				cli.IntFlag{Name: `open-issues`, Usage: ``},
-				cli.StringFlag{Name: `updated-at`, Usage: ``},
+				cli.StringFlag{Name: `state`, Usage: ``},
 				cli.StringFlag{Name: `title`, Usage: ``},
-				cli.StringFlag{Name: `description`, Usage: ``},
+				cli.StringFlag{Name: `updated-at`, Usage: ``},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the Milestone, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 3 {
-					showHelp(c, "edit-milestone", "edit-milestone <owner> <repo> <number>")
+				args := repoArgs(c, 1)
+				if len(args) < 3 {
+					showHelp(c, "edit-milestone", "edit-milestone [<owner> <repo>] <number>")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				number, err := strconv.Atoi(c.Args().Get(2))
+				owner := args.Get(0)
+				repo := args.Get(1)
+				number, err := strconv.Atoi(args.Get(2))
 				check(err)
-				milestone := &github.Milestone{
-					ClosedIssues: github.Int(c.Int("closed-issues")),
-					CreatedAt:    timePointer(now.MustParse(c.String("created-at"))),
-					DueOn:        timePointer(now.MustParse(c.String("due-on"))),
-					Number:       github.Int(c.Int("number")),
-					State:        github.String(c.String("state")),
-					OpenIssues:   github.Int(c.Int("open-issues")),
-					UpdatedAt:    timePointer(now.MustParse(c.String("updated-at"))),
-					Title:        github.String(c.String("title")),
-					Description:  github.String(c.String("description")),
+				milestone := &github.Milestone{}
+				check(decodeFile(c.String("body-file"), milestone))
+				if c.IsSet("closed-issues") {
+					milestone.ClosedIssues = github.Int(c.Int("closed-issues"))
+				}
+				if c.IsSet("created-at") {
+					milestone.CreatedAt = timePointer(timeFlag(c, "created-at"))
+				}
+				if c.IsSet("description") {
+					milestone.Description = github.String(c.String("description"))
+				}
+				if c.IsSet("due-on") {
+					milestone.DueOn = timePointer(timeFlag(c, "due-on"))
+				}
+				if c.IsSet("number") {
+					milestone.Number = github.Int(c.Int("number"))
+				}
+				if c.IsSet("open-issues") {
+					milestone.OpenIssues = github.Int(c.Int("open-issues"))
+				}
+				if c.IsSet("state") {
+					milestone.State = github.String(c.String("state"))
+				}
+				if c.IsSet("title") {
+					milestone.Title = github.String(c.String("title"))
+				}
+				if c.IsSet("updated-at") {
+					milestone.UpdatedAt = timePointer(timeFlag(c, "updated-at"))
 				}
 
 				result, res, err := app.gh.Issues.EditMilestone(owner, repo, number, milestone)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "Milestone", result)
 
 			},
 		}, cli.Command{
@@ -1147,19 +1355,24 @@ Default is "asc".`},
 			Description: `delete-milestone deletes a milestone.
 
    GitHub API docs: https://developer.github.com/v3/issues/milestones/#delete-a-milestone`,
-			Flags: []cli.Flag{},
+			Flags: []cli.Flag{
+				cli.BoolFlag{Name: `yes`, Usage: `Don't ask for confirmation`},
+			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 3 {
-					showHelp(c, "delete-milestone", "delete-milestone <owner> <repo> <number>")
+				args := repoArgs(c, 1)
+				if len(args) < 3 {
+					showHelp(c, "delete-milestone", "delete-milestone [<owner> <repo>] <number>")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				number, err := strconv.Atoi(c.Args().Get(2))
+				owner := args.Get(0)
+				repo := args.Get(1)
+				number, err := strconv.Atoi(args.Get(2))
 				check(err)
 
+				confirm(c, "delete-milestone", repo)
+
 				res, err := app.gh.Issues.DeleteMilestone(owner, repo, number)
-				checkResponse(res.Response, err)
+				checkResponse(res, err)
 
 			},
 		},
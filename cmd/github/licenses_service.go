@@ -1,11 +1,6 @@
 package main
 
-import (
-	"fmt"
-
-	"github.com/codegangsta/cli"
-	"github.com/kr/pretty"
-)
+import "github.com/codegangsta/cli"
 
 var LicensesService = cli.Command{
 	Name:     "licenses",
@@ -22,8 +17,8 @@ var LicensesService = cli.Command{
 			Action: func(c *cli.Context) {
 
 				result, res, err := app.gh.Licenses.List()
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "License", result)
 
 			},
 		}, cli.Command{
@@ -34,15 +29,16 @@ var LicensesService = cli.Command{
    GitHub API docs: https://developer.github.com/v3/licenses/#get-an-individual-license`,
 			Flags: []cli.Flag{},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 1 {
+				args := c.Args()
+				if len(args) < 1 {
 					showHelp(c, "get", "get <license-name>")
 				}
 
-				licenseName := c.Args().Get(0)
+				licenseName := args.Get(0)
 
 				result, res, err := app.gh.Licenses.Get(licenseName)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "License", result)
 
 			},
 		},
@@ -1,13 +1,10 @@
 package main
 
 import (
-	"fmt"
 	"strconv"
 
 	"github.com/codegangsta/cli"
 	"github.com/google/go-github/github"
-	"github.com/jinzhu/now"
-	"github.com/kr/pretty"
 )
 
 var OrganizationsService = cli.Command{
@@ -26,32 +23,34 @@ var OrganizationsService = cli.Command{
 				cli.IntFlag{Name: `page`, Usage: `For paginated result sets, page of results to retrieve.`},
 				cli.IntFlag{Name: `per-page`, Usage: `For paginated result sets, the number of results to include per page.`},
 				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
+				cli.IntFlag{Name: `limit`, Usage: `Stop after this many items, fetching the following pages as needed`},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the ListOptions, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 1 {
+				args := c.Args()
+				if len(args) < 1 {
 					showHelp(c, "list", "list <user>")
 				}
 
-				user := c.Args().Get(0)
-				opt := &github.ListOptions{
-					Page:    c.Int("page"),
-					PerPage: c.Int("per-page"),
+				user := args.Get(0)
+				opt := &github.ListOptions{}
+				check(decodeFile(c.String("body-file"), opt))
+				if c.IsSet("page") {
+					opt.Page = c.Int("page")
+				}
+				if c.IsSet("per-page") {
+					opt.PerPage = c.Int("per-page")
 				}
 
-				var items []github.Organization
-
-				for {
-					page, res, err := app.gh.Organizations.List(user, opt)
-					checkResponse(res.Response, err)
-
-					items = append(items, page...)
-					if res.NextPage == 0 || !c.Bool("all") {
-						break
-					}
-					opt.Page = res.NextPage
+				if opt.PerPage == 0 {
+					opt.PerPage = app.profile.PerPage
 				}
 
-				fmt.Printf("%# v", pretty.Formatter(items))
+				paginate(c, "Organization", func(page int) (interface{}, *github.Response, error) {
+					opt := *opt
+					opt.Page = page
+					return app.gh.Organizations.List(user, &opt)
+				})
 			},
 		}, cli.Command{
 			Name:  "get",
@@ -61,15 +60,16 @@ var OrganizationsService = cli.Command{
    GitHub API docs: http://developer.github.com/v3/orgs/#get-an-organization`,
 			Flags: []cli.Flag{},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 1 {
+				args := c.Args()
+				if len(args) < 1 {
 					showHelp(c, "get", "get <org>")
 				}
 
-				org := c.Args().Get(0)
+				org := args.Get(0)
 
 				result, res, err := app.gh.Organizations.Get(org)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "Organization", result)
 
 			},
 		}, cli.Command{
@@ -79,63 +79,105 @@ var OrganizationsService = cli.Command{
 
    GitHub API docs: http://developer.github.com/v3/orgs/#edit-an-organization`,
 			Flags: []cli.Flag{
-				cli.StringFlag{Name: `login`, Usage: ``},
 				cli.StringFlag{Name: `billing-email`, Usage: ``},
-				cli.StringFlag{Name: `created-at`, Usage: ``},
-				cli.StringFlag{Name: `plan-name`, Usage: ``},
-				cli.IntFlag{Name: `plan-space`, Usage: ``},
-				cli.IntFlag{Name: `plan-collaborators`, Usage: ``},
-				cli.IntFlag{Name: `plan-private-repos`, Usage: ``},
-				cli.IntFlag{Name: `id`, Usage: ``},
-				cli.StringFlag{Name: `location`, Usage: ``},
-				cli.StringFlag{Name: `updated-at`, Usage: ``},
-				cli.IntFlag{Name: `total-private-repos`, Usage: ``},
-				cli.StringFlag{Name: `type`, Usage: ``},
-				cli.StringFlag{Name: `company`, Usage: ``},
 				cli.StringFlag{Name: `blog`, Usage: ``},
+				cli.IntFlag{Name: `collaborators`, Usage: ``},
+				cli.StringFlag{Name: `company`, Usage: ``},
+				cli.StringFlag{Name: `created-at`, Usage: ``},
+				cli.IntFlag{Name: `disk-usage`, Usage: ``},
 				cli.StringFlag{Name: `email`, Usage: ``},
-				cli.IntFlag{Name: `public-gists`, Usage: ``},
-				cli.IntFlag{Name: `public-repos`, Usage: ``},
+				cli.IntFlag{Name: `followers`, Usage: ``},
 				cli.IntFlag{Name: `following`, Usage: ``},
-				cli.IntFlag{Name: `owned-private-repos`, Usage: ``},
+				cli.IntFlag{Name: `id`, Usage: ``},
+				cli.StringFlag{Name: `location`, Usage: ``},
+				cli.StringFlag{Name: `login`, Usage: ``},
 				cli.StringFlag{Name: `name`, Usage: ``},
-				cli.IntFlag{Name: `followers`, Usage: ``},
+				cli.IntFlag{Name: `owned-private-repos`, Usage: ``},
+				cli.IntFlag{Name: `plan-collaborators`, Usage: ``},
+				cli.StringFlag{Name: `plan-name`, Usage: ``},
+				cli.IntFlag{Name: `plan-private-repos`, Usage: ``},
+				cli.IntFlag{Name: `plan-space`, Usage: ``},
 				cli.IntFlag{Name: `private-gists`, Usage: ``},
-				cli.IntFlag{Name: `disk-usage`, Usage: ``},
-				cli.IntFlag{Name: `collaborators`, Usage: ``},
+				cli.IntFlag{Name: `public-gists`, Usage: ``},
+				cli.IntFlag{Name: `public-repos`, Usage: ``},
+				cli.IntFlag{Name: `total-private-repos`, Usage: ``},
+				cli.StringFlag{Name: `type`, Usage: ``},
+				cli.StringFlag{Name: `updated-at`, Usage: ``},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the Organization, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 1 {
+				args := c.Args()
+				if len(args) < 1 {
 					showHelp(c, "edit", "edit <name>")
 				}
 
-				name := c.Args().Get(0)
-				org := &github.Organization{
-					Type:              github.String(c.String("type")),
-					Company:           github.String(c.String("company")),
-					Blog:              github.String(c.String("blog")),
-					Email:             github.String(c.String("email")),
-					PublicGists:       github.Int(c.Int("public-gists")),
-					UpdatedAt:         timePointer(now.MustParse(c.String("updated-at"))),
-					TotalPrivateRepos: github.Int(c.Int("total-private-repos")),
-					PublicRepos:       github.Int(c.Int("public-repos")),
-					Following:         github.Int(c.Int("following")),
-					OwnedPrivateRepos: github.Int(c.Int("owned-private-repos")),
-					Name:              github.String(c.String("name")),
-					Followers:         github.Int(c.Int("followers")),
-					PrivateGists:      github.Int(c.Int("private-gists")),
-					DiskUsage:         github.Int(c.Int("disk-usage")),
-					Collaborators:     github.Int(c.Int("collaborators")),
-					Login:             github.String(c.String("login")),
-					BillingEmail:      github.String(c.String("billing-email")),
-					CreatedAt:         timePointer(now.MustParse(c.String("created-at"))),
-					ID:                github.Int(c.Int("id")),
-					Location:          github.String(c.String("location")),
+				name := args.Get(0)
+				org := &github.Organization{}
+				check(decodeFile(c.String("body-file"), org))
+				if c.IsSet("billing-email") {
+					org.BillingEmail = github.String(c.String("billing-email"))
+				}
+				if c.IsSet("blog") {
+					org.Blog = github.String(c.String("blog"))
+				}
+				if c.IsSet("collaborators") {
+					org.Collaborators = github.Int(c.Int("collaborators"))
+				}
+				if c.IsSet("company") {
+					org.Company = github.String(c.String("company"))
+				}
+				if c.IsSet("created-at") {
+					org.CreatedAt = timePointer(timeFlag(c, "created-at"))
+				}
+				if c.IsSet("disk-usage") {
+					org.DiskUsage = github.Int(c.Int("disk-usage"))
+				}
+				if c.IsSet("email") {
+					org.Email = github.String(c.String("email"))
+				}
+				if c.IsSet("followers") {
+					org.Followers = github.Int(c.Int("followers"))
+				}
+				if c.IsSet("following") {
+					org.Following = github.Int(c.Int("following"))
+				}
+				if c.IsSet("id") {
+					org.ID = github.Int(c.Int("id"))
+				}
+				if c.IsSet("location") {
+					org.Location = github.String(c.String("location"))
+				}
+				if c.IsSet("login") {
+					org.Login = github.String(c.String("login"))
+				}
+				if c.IsSet("name") {
+					org.Name = github.String(c.String("name"))
+				}
+				if c.IsSet("owned-private-repos") {
+					org.OwnedPrivateRepos = github.Int(c.Int("owned-private-repos"))
+				}
+				if c.IsSet("private-gists") {
+					org.PrivateGists = github.Int(c.Int("private-gists"))
+				}
+				if c.IsSet("public-gists") {
+					org.PublicGists = github.Int(c.Int("public-gists"))
+				}
+				if c.IsSet("public-repos") {
+					org.PublicRepos = github.Int(c.Int("public-repos"))
+				}
+				if c.IsSet("total-private-repos") {
+					org.TotalPrivateRepos = github.Int(c.Int("total-private-repos"))
+				}
+				if c.IsSet("type") {
+					org.Type = github.String(c.String("type"))
+				}
+				if c.IsSet("updated-at") {
+					org.UpdatedAt = timePointer(timeFlag(c, "updated-at"))
 				}
 
 				result, res, err := app.gh.Organizations.Edit(name, org)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "Organization", result)
 
 			},
 		}, cli.Command{
@@ -148,32 +190,34 @@ var OrganizationsService = cli.Command{
 				cli.IntFlag{Name: `page`, Usage: `For paginated result sets, page of results to retrieve.`},
 				cli.IntFlag{Name: `per-page`, Usage: `For paginated result sets, the number of results to include per page.`},
 				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
+				cli.IntFlag{Name: `limit`, Usage: `Stop after this many items, fetching the following pages as needed`},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the ListOptions, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 1 {
+				args := c.Args()
+				if len(args) < 1 {
 					showHelp(c, "list-hooks", "list-hooks <org>")
 				}
 
-				org := c.Args().Get(0)
-				opt := &github.ListOptions{
-					Page:    c.Int("page"),
-					PerPage: c.Int("per-page"),
+				org := args.Get(0)
+				opt := &github.ListOptions{}
+				check(decodeFile(c.String("body-file"), opt))
+				if c.IsSet("page") {
+					opt.Page = c.Int("page")
+				}
+				if c.IsSet("per-page") {
+					opt.PerPage = c.Int("per-page")
 				}
 
-				var items []github.Hook
-
-				for {
-					page, res, err := app.gh.Organizations.ListHooks(org, opt)
-					checkResponse(res.Response, err)
-
-					items = append(items, page...)
-					if res.NextPage == 0 || !c.Bool("all") {
-						break
-					}
-					opt.Page = res.NextPage
+				if opt.PerPage == 0 {
+					opt.PerPage = app.profile.PerPage
 				}
 
-				fmt.Printf("%# v", pretty.Formatter(items))
+				paginate(c, "Hook", func(page int) (interface{}, *github.Response, error) {
+					opt := *opt
+					opt.Page = page
+					return app.gh.Organizations.ListHooks(org, &opt)
+				})
 			},
 		}, cli.Command{
 			Name:  "get-hook",
@@ -183,17 +227,18 @@ var OrganizationsService = cli.Command{
    GitHub API docs: https://developer.github.com/v3/orgs/hooks/#get-single-hook`,
 			Flags: []cli.Flag{},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 2 {
+				args := c.Args()
+				if len(args) < 2 {
 					showHelp(c, "get-hook", "get-hook <org> <id>")
 				}
 
-				org := c.Args().Get(0)
-				id, err := strconv.Atoi(c.Args().Get(1))
+				org := args.Get(0)
+				id, err := strconv.Atoi(args.Get(1))
 				check(err)
 
 				result, res, err := app.gh.Organizations.GetHook(org, id)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "Hook", result)
 
 			},
 		}, cli.Command{
@@ -204,31 +249,45 @@ var OrganizationsService = cli.Command{
 
    GitHub API docs: https://developer.github.com/v3/orgs/hooks/#create-a-hook`,
 			Flags: []cli.Flag{
-				cli.IntFlag{Name: `id`, Usage: ``},
+				cli.BoolFlag{Name: `active`, Usage: ``},
 				cli.StringFlag{Name: `created-at`, Usage: ``},
-				cli.StringFlag{Name: `updated-at`, Usage: ``},
-				cli.StringFlag{Name: `name`, Usage: ``},
 				cli.StringSliceFlag{Name: `events`, Usage: ``},
-				cli.BoolFlag{Name: `active`, Usage: ``},
+				cli.IntFlag{Name: `id`, Usage: ``},
+				cli.StringFlag{Name: `name`, Usage: ``},
+				cli.StringFlag{Name: `updated-at`, Usage: ``},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the Hook, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 1 {
+				args := c.Args()
+				if len(args) < 1 {
 					showHelp(c, "create-hook", "create-hook <org>")
 				}
 
-				org := c.Args().Get(0)
-				hook := &github.Hook{
-					Name:      github.String(c.String("name")),
-					Events:    c.StringSlice("events"),
-					Active:    github.Bool(c.Bool("active")),
-					ID:        github.Int(c.Int("id")),
-					CreatedAt: timePointer(now.MustParse(c.String("created-at"))),
-					UpdatedAt: timePointer(now.MustParse(c.String("updated-at"))),
+				org := args.Get(0)
+				hook := &github.Hook{}
+				check(decodeFile(c.String("body-file"), hook))
+				if c.IsSet("active") {
+					hook.Active = github.Bool(c.Bool("active"))
+				}
+				if c.IsSet("created-at") {
+					hook.CreatedAt = timePointer(timeFlag(c, "created-at"))
+				}
+				if c.IsSet("events") {
+					hook.Events = c.StringSlice("events")
+				}
+				if c.IsSet("id") {
+					hook.ID = github.Int(c.Int("id"))
+				}
+				if c.IsSet("name") {
+					hook.Name = github.String(c.String("name"))
+				}
+				if c.IsSet("updated-at") {
+					hook.UpdatedAt = timePointer(timeFlag(c, "updated-at"))
 				}
 
 				result, res, err := app.gh.Organizations.CreateHook(org, hook)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "Hook", result)
 
 			},
 		}, cli.Command{
@@ -238,33 +297,47 @@ var OrganizationsService = cli.Command{
 
    GitHub API docs: https://developer.github.com/v3/orgs/hooks/#edit-a-hook`,
 			Flags: []cli.Flag{
-				cli.StringSliceFlag{Name: `events`, Usage: ``},
 				cli.BoolFlag{Name: `active`, Usage: ``},
-				cli.IntFlag{Name: `id`, Usage: ``},
 				cli.StringFlag{Name: `created-at`, Usage: ``},
-				cli.StringFlag{Name: `updated-at`, Usage: ``},
+				cli.StringSliceFlag{Name: `events`, Usage: ``},
+				cli.IntFlag{Name: `id`, Usage: ``},
 				cli.StringFlag{Name: `name`, Usage: ``},
+				cli.StringFlag{Name: `updated-at`, Usage: ``},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the Hook, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 2 {
+				args := c.Args()
+				if len(args) < 2 {
 					showHelp(c, "edit-hook", "edit-hook <org> <id>")
 				}
 
-				org := c.Args().Get(0)
-				id, err := strconv.Atoi(c.Args().Get(1))
+				org := args.Get(0)
+				id, err := strconv.Atoi(args.Get(1))
 				check(err)
-				hook := &github.Hook{
-					ID:        github.Int(c.Int("id")),
-					CreatedAt: timePointer(now.MustParse(c.String("created-at"))),
-					UpdatedAt: timePointer(now.MustParse(c.String("updated-at"))),
-					Name:      github.String(c.String("name")),
-					Events:    c.StringSlice("events"),
-					Active:    github.Bool(c.Bool("active")),
+				hook := &github.Hook{}
+				check(decodeFile(c.String("body-file"), hook))
+				if c.IsSet("active") {
+					hook.Active = github.Bool(c.Bool("active"))
+				}
+				if c.IsSet("created-at") {
+					hook.CreatedAt = timePointer(timeFlag(c, "created-at"))
+				}
+				if c.IsSet("events") {
+					hook.Events = c.StringSlice("events")
+				}
+				if c.IsSet("id") {
+					hook.ID = github.Int(c.Int("id"))
+				}
+				if c.IsSet("name") {
+					hook.Name = github.String(c.String("name"))
+				}
+				if c.IsSet("updated-at") {
+					hook.UpdatedAt = timePointer(timeFlag(c, "updated-at"))
 				}
 
 				result, res, err := app.gh.Organizations.EditHook(org, id, hook)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "Hook", result)
 
 			},
 		}, cli.Command{
@@ -275,16 +348,17 @@ var OrganizationsService = cli.Command{
    GitHub API docs: https://developer.github.com/v3/orgs/hooks/#ping-a-hook`,
 			Flags: []cli.Flag{},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 2 {
+				args := c.Args()
+				if len(args) < 2 {
 					showHelp(c, "ping-hook", "ping-hook <org> <id>")
 				}
 
-				org := c.Args().Get(0)
-				id, err := strconv.Atoi(c.Args().Get(1))
+				org := args.Get(0)
+				id, err := strconv.Atoi(args.Get(1))
 				check(err)
 
 				res, err := app.gh.Organizations.PingHook(org, id)
-				checkResponse(res.Response, err)
+				checkResponse(res, err)
 
 			},
 		}, cli.Command{
@@ -293,18 +367,23 @@ var OrganizationsService = cli.Command{
 			Description: `delete-hook deletes a specified Hook.
 
    GitHub API docs: https://developer.github.com/v3/orgs/hooks/#delete-a-hook`,
-			Flags: []cli.Flag{},
+			Flags: []cli.Flag{
+				cli.BoolFlag{Name: `yes`, Usage: `Don't ask for confirmation`},
+			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 2 {
+				args := c.Args()
+				if len(args) < 2 {
 					showHelp(c, "delete-hook", "delete-hook <org> <id>")
 				}
 
-				org := c.Args().Get(0)
-				id, err := strconv.Atoi(c.Args().Get(1))
+				org := args.Get(0)
+				id, err := strconv.Atoi(args.Get(1))
 				check(err)
 
+				confirm(c, "delete-hook", strconv.Itoa(id))
+
 				res, err := app.gh.Organizations.DeleteHook(org, id)
-				checkResponse(res.Response, err)
+				checkResponse(res, err)
 
 			},
 		}, cli.Command{
@@ -316,39 +395,47 @@ var OrganizationsService = cli.Command{
 
    GitHub API docs: http://developer.github.com/v3/orgs/members/#members-list`,
 			Flags: []cli.Flag{
-				cli.BoolFlag{Name: `public-only`, Usage: `If true (or if the authenticated user is not an owner of the
-organization), list only publicly visible members.`},
+				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
 				cli.StringFlag{Name: `filter`, Usage: `Filter members returned in the list.  Possible values are:
 2fa_disabled, all.  Default is "all".`},
-				cli.IntFlag{Name: `per-page`, Usage: `For paginated result sets, the number of results to include per page.`},
+				cli.IntFlag{Name: `limit`, Usage: `Stop after this many items, fetching the following pages as needed`},
 				cli.IntFlag{Name: `page`, Usage: `For paginated result sets, page of results to retrieve.`},
-				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
+				cli.IntFlag{Name: `per-page`, Usage: `For paginated result sets, the number of results to include per page.`},
+				cli.BoolFlag{Name: `public-only`, Usage: `If true (or if the authenticated user is not an owner of the
+organization), list only publicly visible members.`},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the ListMembersOptions, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 1 {
+				args := c.Args()
+				if len(args) < 1 {
 					showHelp(c, "list-members", "list-members <org>")
 				}
 
-				org := c.Args().Get(0)
-				opt := &github.ListMembersOptions{
-					PublicOnly: c.Bool("public-only"),
-					Filter:     c.String("filter"),
+				org := args.Get(0)
+				opt := &github.ListMembersOptions{}
+				check(decodeFile(c.String("body-file"), opt))
+				if c.IsSet("filter") {
+					opt.Filter = c.String("filter")
+				}
+				if c.IsSet("public-only") {
+					opt.PublicOnly = c.Bool("public-only")
+				}
+				if c.IsSet("page") {
+					opt.Page = c.Int("page")
+				}
+				if c.IsSet("per-page") {
+					opt.PerPage = c.Int("per-page")
 				}
 
-				var items []github.User
-
-				for {
-					page, res, err := app.gh.Organizations.ListMembers(org, opt)
-					checkResponse(res.Response, err)
-
-					items = append(items, page...)
-					if res.NextPage == 0 || !c.Bool("all") {
-						break
-					}
-					opt.Page = res.NextPage
+				if opt.PerPage == 0 {
+					opt.PerPage = app.profile.PerPage
 				}
 
-				fmt.Printf("%# v", pretty.Formatter(items))
+				paginate(c, "User", func(page int) (interface{}, *github.Response, error) {
+					opt := *opt
+					opt.Page = page
+					return app.gh.Organizations.ListMembers(org, &opt)
+				})
 			},
 		}, cli.Command{
 			Name:  "is-member",
@@ -358,16 +445,17 @@ organization), list only publicly visible members.`},
    GitHub API docs: http://developer.github.com/v3/orgs/members/#check-membership`,
 			Flags: []cli.Flag{},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 2 {
+				args := c.Args()
+				if len(args) < 2 {
 					showHelp(c, "is-member", "is-member <org> <user>")
 				}
 
-				org := c.Args().Get(0)
-				user := c.Args().Get(1)
+				org := args.Get(0)
+				user := args.Get(1)
 
 				result, res, err := app.gh.Organizations.IsMember(org, user)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "bool", result)
 
 			},
 		}, cli.Command{
@@ -378,16 +466,17 @@ organization), list only publicly visible members.`},
    GitHub API docs: http://developer.github.com/v3/orgs/members/#check-public-membership`,
 			Flags: []cli.Flag{},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 2 {
+				args := c.Args()
+				if len(args) < 2 {
 					showHelp(c, "is-public-member", "is-public-member <org> <user>")
 				}
 
-				org := c.Args().Get(0)
-				user := c.Args().Get(1)
+				org := args.Get(0)
+				user := args.Get(1)
 
 				result, res, err := app.gh.Organizations.IsPublicMember(org, user)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "bool", result)
 
 			},
 		}, cli.Command{
@@ -396,17 +485,22 @@ organization), list only publicly visible members.`},
 			Description: `remove-member removes a user from all teams of an organization.
 
    GitHub API docs: http://developer.github.com/v3/orgs/members/#remove-a-member`,
-			Flags: []cli.Flag{},
+			Flags: []cli.Flag{
+				cli.BoolFlag{Name: `yes`, Usage: `Don't ask for confirmation`},
+			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 2 {
+				args := c.Args()
+				if len(args) < 2 {
 					showHelp(c, "remove-member", "remove-member <org> <user>")
 				}
 
-				org := c.Args().Get(0)
-				user := c.Args().Get(1)
+				org := args.Get(0)
+				user := args.Get(1)
+
+				confirm(c, "remove-member", user)
 
 				res, err := app.gh.Organizations.RemoveMember(org, user)
-				checkResponse(res.Response, err)
+				checkResponse(res, err)
 
 			},
 		}, cli.Command{
@@ -417,15 +511,16 @@ organization), list only publicly visible members.`},
    GitHub API docs: http://developer.github.com/v3/orgs/members/#publicize-a-users-membership`,
 			Flags: []cli.Flag{},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 2 {
+				args := c.Args()
+				if len(args) < 2 {
 					showHelp(c, "publicize-membership", "publicize-membership <org> <user>")
 				}
 
-				org := c.Args().Get(0)
-				user := c.Args().Get(1)
+				org := args.Get(0)
+				user := args.Get(1)
 
 				res, err := app.gh.Organizations.PublicizeMembership(org, user)
-				checkResponse(res.Response, err)
+				checkResponse(res, err)
 
 			},
 		}, cli.Command{
@@ -434,17 +529,22 @@ organization), list only publicly visible members.`},
 			Description: `conceal-membership conceals a user's membership in an organization.
 
    GitHub API docs: http://developer.github.com/v3/orgs/members/#conceal-a-users-membership`,
-			Flags: []cli.Flag{},
+			Flags: []cli.Flag{
+				cli.BoolFlag{Name: `yes`, Usage: `Don't ask for confirmation`},
+			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 2 {
+				args := c.Args()
+				if len(args) < 2 {
 					showHelp(c, "conceal-membership", "conceal-membership <org> <user>")
 				}
 
-				org := c.Args().Get(0)
-				user := c.Args().Get(1)
+				org := args.Get(0)
+				user := args.Get(1)
+
+				confirm(c, "conceal-membership", user)
 
 				res, err := app.gh.Organizations.ConcealMembership(org, user)
-				checkResponse(res.Response, err)
+				checkResponse(res, err)
 
 			},
 		}, cli.Command{
@@ -454,31 +554,36 @@ organization), list only publicly visible members.`},
 
    GitHub API docs: https://developer.github.com/v3/orgs/members/#list-your-organization-memberships`,
 			Flags: []cli.Flag{
+				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
+				cli.IntFlag{Name: `limit`, Usage: `Stop after this many items, fetching the following pages as needed`},
+				cli.IntFlag{Name: `page`, Usage: `For paginated result sets, page of results to retrieve.`},
+				cli.IntFlag{Name: `per-page`, Usage: `For paginated result sets, the number of results to include per page.`},
 				cli.StringFlag{Name: `state`, Usage: `Filter memberships to include only those withe the specified state.
 Possible values are: "active", "pending".`},
-				cli.IntFlag{Name: `per-page`, Usage: `For paginated result sets, the number of results to include per page.`},
-				cli.IntFlag{Name: `page`, Usage: `For paginated result sets, page of results to retrieve.`},
-				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the ListOrgMembershipsOptions, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				opt := &github.ListOrgMembershipsOptions{
-					State: c.String("state"),
+				opt := &github.ListOrgMembershipsOptions{}
+				check(decodeFile(c.String("body-file"), opt))
+				if c.IsSet("state") {
+					opt.State = c.String("state")
+				}
+				if c.IsSet("page") {
+					opt.Page = c.Int("page")
+				}
+				if c.IsSet("per-page") {
+					opt.PerPage = c.Int("per-page")
 				}
 
-				var items []github.Membership
-
-				for {
-					page, res, err := app.gh.Organizations.ListOrgMemberships(opt)
-					checkResponse(res.Response, err)
-
-					items = append(items, page...)
-					if res.NextPage == 0 || !c.Bool("all") {
-						break
-					}
-					opt.Page = res.NextPage
+				if opt.PerPage == 0 {
+					opt.PerPage = app.profile.PerPage
 				}
 
-				fmt.Printf("%# v", pretty.Formatter(items))
+				paginate(c, "Membership", func(page int) (interface{}, *github.Response, error) {
+					opt := *opt
+					opt.Page = page
+					return app.gh.Organizations.ListOrgMemberships(&opt)
+				})
 			},
 		}, cli.Command{
 			Name:  "get-org-membership",
@@ -489,15 +594,16 @@ Possible values are: "active", "pending".`},
    GitHub API docs: https://developer.github.com/v3/orgs/members/#get-your-organization-membership`,
 			Flags: []cli.Flag{},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 1 {
+				args := c.Args()
+				if len(args) < 1 {
 					showHelp(c, "get-org-membership", "get-org-membership <org>")
 				}
 
-				org := c.Args().Get(0)
+				org := args.Get(0)
 
 				result, res, err := app.gh.Organizations.GetOrgMembership(org)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "Membership", result)
 
 			},
 		}, cli.Command{
@@ -508,75 +614,81 @@ Possible values are: "active", "pending".`},
 
    GitHub API docs: https://developer.github.com/v3/orgs/members/#edit-your-organization-membership`,
 			Flags: []cli.Flag{
+				cli.StringFlag{Name: `organization-billing-email`, Usage: ``},
+				cli.StringFlag{Name: `organization-blog`, Usage: ``},
+				cli.IntFlag{Name: `organization-collaborators`, Usage: ``},
+				cli.StringFlag{Name: `organization-company`, Usage: ``},
 				cli.StringFlag{Name: `organization-created-at`, Usage: ``},
-				cli.StringFlag{Name: `organization-plan-name`, Usage: ``},
-				cli.IntFlag{Name: `organization-plan-space`, Usage: ``},
-				cli.IntFlag{Name: `organization-plan-collaborators`, Usage: ``},
-				cli.IntFlag{Name: `organization-plan-private-repos`, Usage: ``},
+				cli.IntFlag{Name: `organization-disk-usage`, Usage: ``},
+				cli.StringFlag{Name: `organization-email`, Usage: ``},
+				cli.IntFlag{Name: `organization-followers`, Usage: ``},
+				cli.IntFlag{Name: `organization-following`, Usage: ``},
 				cli.IntFlag{Name: `organization-id`, Usage: ``},
 				cli.StringFlag{Name: `organization-location`, Usage: ``},
+				cli.StringFlag{Name: `organization-login`, Usage: ``},
+				cli.StringFlag{Name: `organization-name`, Usage: ``},
+				cli.IntFlag{Name: `organization-owned-private-repos`, Usage: ``},
+				cli.IntFlag{Name: `organization-plan-collaborators`, Usage: ``},
+				cli.StringFlag{Name: `organization-plan-name`, Usage: ``},
+				cli.IntFlag{Name: `organization-plan-private-repos`, Usage: ``},
+				cli.IntFlag{Name: `organization-plan-space`, Usage: ``},
+				cli.IntFlag{Name: `organization-private-gists`, Usage: ``},
 				cli.IntFlag{Name: `organization-public-gists`, Usage: ``},
-				cli.StringFlag{Name: `organization-updated-at`, Usage: ``},
+				cli.IntFlag{Name: `organization-public-repos`, Usage: ``},
 				cli.IntFlag{Name: `organization-total-private-repos`, Usage: ``},
 				cli.StringFlag{Name: `organization-type`, Usage: ``},
-				cli.StringFlag{Name: `organization-company`, Usage: ``},
-				cli.StringFlag{Name: `organization-blog`, Usage: ``},
-				cli.StringFlag{Name: `organization-email`, Usage: ``},
-				cli.IntFlag{Name: `organization-public-repos`, Usage: ``},
-				cli.IntFlag{Name: `organization-following`, Usage: ``},
-				cli.IntFlag{Name: `organization-owned-private-repos`, Usage: ``},
-				cli.StringFlag{Name: `organization-name`, Usage: ``},
-				cli.IntFlag{Name: `organization-collaborators`, Usage: ``},
-				cli.IntFlag{Name: `organization-followers`, Usage: ``},
-				cli.IntFlag{Name: `organization-private-gists`, Usage: ``},
-				cli.IntFlag{Name: `organization-disk-usage`, Usage: ``},
-				cli.StringFlag{Name: `organization-login`, Usage: ``},
-				cli.StringFlag{Name: `organization-billing-email`, Usage: ``},
+				cli.StringFlag{Name: `organization-updated-at`, Usage: ``},
+				cli.StringFlag{Name: `role`, Usage: `TODO(willnorris): add docs`},
+				cli.StringFlag{Name: `state`, Usage: `State is the user's status within the organization or team.
+Possible values are: "active", "pending"`},
 				cli.StringFlag{Name: `user-bio`, Usage: ``},
-				cli.BoolFlag{Name: `user-site-admin`, Usage: ``},
-				cli.IntFlag{Name: `user-disk-usage`, Usage: ``},
-				cli.StringFlag{Name: `user-location`, Usage: ``},
-				cli.BoolFlag{Name: `user-hireable`, Usage: ``},
+				cli.StringFlag{Name: `user-blog`, Usage: ``},
+				cli.IntFlag{Name: `user-collaborators`, Usage: ``},
 				cli.StringFlag{Name: `user-company`, Usage: ``},
+				cli.StringFlag{Name: `user-created-at`, Usage: ``},
+				cli.IntFlag{Name: `user-disk-usage`, Usage: ``},
 				cli.StringFlag{Name: `user-email`, Usage: ``},
+				cli.IntFlag{Name: `user-followers`, Usage: ``},
 				cli.IntFlag{Name: `user-following`, Usage: ``},
-				cli.StringFlag{Name: `user-type`, Usage: ``},
+				cli.StringFlag{Name: `user-gravatar-id`, Usage: ``},
+				cli.BoolFlag{Name: `user-hireable`, Usage: ``},
+				cli.IntFlag{Name: `user-id`, Usage: ``},
+				cli.StringFlag{Name: `user-location`, Usage: ``},
 				cli.StringFlag{Name: `user-login`, Usage: ``},
-				cli.IntFlag{Name: `user-followers`, Usage: ``},
-				cli.IntFlag{Name: `user-private-gists`, Usage: ``},
 				cli.StringFlag{Name: `user-name`, Usage: ``},
-				cli.IntFlag{Name: `user-public-gists`, Usage: ``},
-				cli.StringFlag{Name: `user-created-at`, Usage: ``},
-				cli.IntFlag{Name: `user-total-private-repos`, Usage: ``},
 				cli.IntFlag{Name: `user-owned-private-repos`, Usage: ``},
-				cli.IntFlag{Name: `user-collaborators`, Usage: ``},
 				cli.IntFlag{Name: `user-plan-collaborators`, Usage: ``},
-				cli.IntFlag{Name: `user-plan-private-repos`, Usage: ``},
 				cli.StringFlag{Name: `user-plan-name`, Usage: ``},
+				cli.IntFlag{Name: `user-plan-private-repos`, Usage: ``},
 				cli.IntFlag{Name: `user-plan-space`, Usage: ``},
-				cli.StringFlag{Name: `user-gravatar-id`, Usage: ``},
-				cli.StringFlag{Name: `user-blog`, Usage: ``},
-				cli.StringFlag{Name: `user-updated-at`, Usage: ``},
-				cli.IntFlag{Name: `user-id`, Usage: ``},
+				cli.IntFlag{Name: `user-private-gists`, Usage: ``},
+				cli.IntFlag{Name: `user-public-gists`, Usage: ``},
 				cli.IntFlag{Name: `user-public-repos`, Usage: ``},
-				cli.StringFlag{Name: `state`, Usage: `State is the user's status within the organization or team.
-Possible values are: "active", "pending"`},
-				cli.StringFlag{Name: `role`, Usage: `TODO(willnorris): add docs`},
+				cli.BoolFlag{Name: `user-site-admin`, Usage: ``},
+				cli.IntFlag{Name: `user-total-private-repos`, Usage: ``},
+				cli.StringFlag{Name: `user-type`, Usage: ``},
+				cli.StringFlag{Name: `user-updated-at`, Usage: ``},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the Membership, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 1 {
+				args := c.Args()
+				if len(args) < 1 {
 					showHelp(c, "edit-org-membership", "edit-org-membership <org>")
 				}
 
-				org := c.Args().Get(0)
-				membership := &github.Membership{
-					State: github.String(c.String("state")),
-					Role:  github.String(c.String("role")),
+				org := args.Get(0)
+				membership := &github.Membership{}
+				check(decodeFile(c.String("body-file"), membership))
+				if c.IsSet("role") {
+					membership.Role = github.String(c.String("role"))
+				}
+				if c.IsSet("state") {
+					membership.State = github.String(c.String("state"))
 				}
 
 				result, res, err := app.gh.Organizations.EditOrgMembership(org, membership)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "Membership", result)
 
 			},
 		}, cli.Command{
@@ -589,32 +701,34 @@ Possible values are: "active", "pending"`},
 				cli.IntFlag{Name: `page`, Usage: `For paginated result sets, page of results to retrieve.`},
 				cli.IntFlag{Name: `per-page`, Usage: `For paginated result sets, the number of results to include per page.`},
 				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
+				cli.IntFlag{Name: `limit`, Usage: `Stop after this many items, fetching the following pages as needed`},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the ListOptions, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 1 {
+				args := c.Args()
+				if len(args) < 1 {
 					showHelp(c, "list-teams", "list-teams <org>")
 				}
 
-				org := c.Args().Get(0)
-				opt := &github.ListOptions{
-					Page:    c.Int("page"),
-					PerPage: c.Int("per-page"),
+				org := args.Get(0)
+				opt := &github.ListOptions{}
+				check(decodeFile(c.String("body-file"), opt))
+				if c.IsSet("page") {
+					opt.Page = c.Int("page")
+				}
+				if c.IsSet("per-page") {
+					opt.PerPage = c.Int("per-page")
 				}
 
-				var items []github.Team
-
-				for {
-					page, res, err := app.gh.Organizations.ListTeams(org, opt)
-					checkResponse(res.Response, err)
-
-					items = append(items, page...)
-					if res.NextPage == 0 || !c.Bool("all") {
-						break
-					}
-					opt.Page = res.NextPage
+				if opt.PerPage == 0 {
+					opt.PerPage = app.profile.PerPage
 				}
 
-				fmt.Printf("%# v", pretty.Formatter(items))
+				paginate(c, "Team", func(page int) (interface{}, *github.Response, error) {
+					opt := *opt
+					opt.Page = page
+					return app.gh.Organizations.ListTeams(org, &opt)
+				})
 			},
 		}, cli.Command{
 			Name:  "get-team",
@@ -624,16 +738,17 @@ Possible values are: "active", "pending"`},
    GitHub API docs: http://developer.github.com/v3/orgs/teams/#get-team`,
 			Flags: []cli.Flag{},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 1 {
+				args := c.Args()
+				if len(args) < 1 {
 					showHelp(c, "get-team", "get-team <team>")
 				}
 
-				team, err := strconv.Atoi(c.Args().Get(0))
+				team, err := strconv.Atoi(args.Get(0))
 				check(err)
 
 				result, res, err := app.gh.Organizations.GetTeam(team)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "Team", result)
 
 			},
 		}, cli.Command{
@@ -643,55 +758,69 @@ Possible values are: "active", "pending"`},
 
    GitHub API docs: http://developer.github.com/v3/orgs/teams/#create-team`,
 			Flags: []cli.Flag{
-				cli.IntFlag{Name: `repos-count`, Usage: ``},
-				cli.IntFlag{Name: `organization-public-repos`, Usage: ``},
-				cli.IntFlag{Name: `organization-following`, Usage: ``},
-				cli.IntFlag{Name: `organization-owned-private-repos`, Usage: ``},
-				cli.StringFlag{Name: `organization-name`, Usage: ``},
-				cli.IntFlag{Name: `organization-followers`, Usage: ``},
-				cli.IntFlag{Name: `organization-private-gists`, Usage: ``},
-				cli.IntFlag{Name: `organization-disk-usage`, Usage: ``},
-				cli.IntFlag{Name: `organization-collaborators`, Usage: ``},
-				cli.StringFlag{Name: `organization-login`, Usage: ``},
+				cli.IntFlag{Name: `id`, Usage: ``},
+				cli.IntFlag{Name: `members-count`, Usage: ``},
+				cli.StringFlag{Name: `name`, Usage: ``},
 				cli.StringFlag{Name: `organization-billing-email`, Usage: ``},
+				cli.StringFlag{Name: `organization-blog`, Usage: ``},
+				cli.IntFlag{Name: `organization-collaborators`, Usage: ``},
+				cli.StringFlag{Name: `organization-company`, Usage: ``},
 				cli.StringFlag{Name: `organization-created-at`, Usage: ``},
-				cli.StringFlag{Name: `organization-plan-name`, Usage: ``},
-				cli.IntFlag{Name: `organization-plan-space`, Usage: ``},
-				cli.IntFlag{Name: `organization-plan-collaborators`, Usage: ``},
-				cli.IntFlag{Name: `organization-plan-private-repos`, Usage: ``},
+				cli.IntFlag{Name: `organization-disk-usage`, Usage: ``},
+				cli.StringFlag{Name: `organization-email`, Usage: ``},
+				cli.IntFlag{Name: `organization-followers`, Usage: ``},
+				cli.IntFlag{Name: `organization-following`, Usage: ``},
 				cli.IntFlag{Name: `organization-id`, Usage: ``},
 				cli.StringFlag{Name: `organization-location`, Usage: ``},
-				cli.StringFlag{Name: `organization-company`, Usage: ``},
-				cli.StringFlag{Name: `organization-blog`, Usage: ``},
-				cli.StringFlag{Name: `organization-email`, Usage: ``},
+				cli.StringFlag{Name: `organization-login`, Usage: ``},
+				cli.StringFlag{Name: `organization-name`, Usage: ``},
+				cli.IntFlag{Name: `organization-owned-private-repos`, Usage: ``},
+				cli.IntFlag{Name: `organization-plan-collaborators`, Usage: ``},
+				cli.StringFlag{Name: `organization-plan-name`, Usage: ``},
+				cli.IntFlag{Name: `organization-plan-private-repos`, Usage: ``},
+				cli.IntFlag{Name: `organization-plan-space`, Usage: ``},
+				cli.IntFlag{Name: `organization-private-gists`, Usage: ``},
 				cli.IntFlag{Name: `organization-public-gists`, Usage: ``},
-				cli.StringFlag{Name: `organization-updated-at`, Usage: ``},
+				cli.IntFlag{Name: `organization-public-repos`, Usage: ``},
 				cli.IntFlag{Name: `organization-total-private-repos`, Usage: ``},
 				cli.StringFlag{Name: `organization-type`, Usage: ``},
-				cli.IntFlag{Name: `id`, Usage: ``},
-				cli.StringFlag{Name: `name`, Usage: ``},
-				cli.StringFlag{Name: `slug`, Usage: ``},
+				cli.StringFlag{Name: `organization-updated-at`, Usage: ``},
 				cli.StringFlag{Name: `permission`, Usage: ``},
-				cli.IntFlag{Name: `members-count`, Usage: ``},
+				cli.IntFlag{Name: `repos-count`, Usage: ``},
+				cli.StringFlag{Name: `slug`, Usage: ``},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the Team, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 1 {
+				args := c.Args()
+				if len(args) < 1 {
 					showHelp(c, "create-team", "create-team <org>")
 				}
 
-				org := c.Args().Get(0)
-				team := &github.Team{
-					Name:         github.String(c.String("name")),
-					Slug:         github.String(c.String("slug")),
-					Permission:   github.String(c.String("permission")),
-					MembersCount: github.Int(c.Int("members-count")),
-					ReposCount:   github.Int(c.Int("repos-count")),
-					ID:           github.Int(c.Int("id")),
+				org := args.Get(0)
+				team := &github.Team{}
+				check(decodeFile(c.String("body-file"), team))
+				if c.IsSet("id") {
+					team.ID = github.Int(c.Int("id"))
+				}
+				if c.IsSet("members-count") {
+					team.MembersCount = github.Int(c.Int("members-count"))
+				}
+				if c.IsSet("name") {
+					team.Name = github.String(c.String("name"))
+				}
+				if c.IsSet("permission") {
+					team.Permission = github.String(c.String("permission"))
+				}
+				if c.IsSet("repos-count") {
+					team.ReposCount = github.Int(c.Int("repos-count"))
+				}
+				if c.IsSet("slug") {
+					team.Slug = github.String(c.String("slug"))
 				}
 
 				result, res, err := app.gh.Organizations.CreateTeam(org, team)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "Team", result)
 
 			},
 		}, cli.Command{
@@ -701,56 +830,70 @@ Possible values are: "active", "pending"`},
 
    GitHub API docs: http://developer.github.com/v3/orgs/teams/#edit-team`,
 			Flags: []cli.Flag{
-				cli.StringFlag{Name: `slug`, Usage: ``},
-				cli.StringFlag{Name: `permission`, Usage: ``},
+				cli.IntFlag{Name: `id`, Usage: ``},
 				cli.IntFlag{Name: `members-count`, Usage: ``},
-				cli.IntFlag{Name: `repos-count`, Usage: ``},
+				cli.StringFlag{Name: `name`, Usage: ``},
+				cli.StringFlag{Name: `organization-billing-email`, Usage: ``},
+				cli.StringFlag{Name: `organization-blog`, Usage: ``},
+				cli.IntFlag{Name: `organization-collaborators`, Usage: ``},
+				cli.StringFlag{Name: `organization-company`, Usage: ``},
 				cli.StringFlag{Name: `organization-created-at`, Usage: ``},
-				cli.StringFlag{Name: `organization-plan-name`, Usage: ``},
-				cli.IntFlag{Name: `organization-plan-space`, Usage: ``},
-				cli.IntFlag{Name: `organization-plan-collaborators`, Usage: ``},
-				cli.IntFlag{Name: `organization-plan-private-repos`, Usage: ``},
+				cli.IntFlag{Name: `organization-disk-usage`, Usage: ``},
+				cli.StringFlag{Name: `organization-email`, Usage: ``},
+				cli.IntFlag{Name: `organization-followers`, Usage: ``},
+				cli.IntFlag{Name: `organization-following`, Usage: ``},
 				cli.IntFlag{Name: `organization-id`, Usage: ``},
 				cli.StringFlag{Name: `organization-location`, Usage: ``},
+				cli.StringFlag{Name: `organization-login`, Usage: ``},
+				cli.StringFlag{Name: `organization-name`, Usage: ``},
+				cli.IntFlag{Name: `organization-owned-private-repos`, Usage: ``},
+				cli.IntFlag{Name: `organization-plan-collaborators`, Usage: ``},
+				cli.StringFlag{Name: `organization-plan-name`, Usage: ``},
+				cli.IntFlag{Name: `organization-plan-private-repos`, Usage: ``},
+				cli.IntFlag{Name: `organization-plan-space`, Usage: ``},
+				cli.IntFlag{Name: `organization-private-gists`, Usage: ``},
 				cli.IntFlag{Name: `organization-public-gists`, Usage: ``},
-				cli.StringFlag{Name: `organization-updated-at`, Usage: ``},
+				cli.IntFlag{Name: `organization-public-repos`, Usage: ``},
 				cli.IntFlag{Name: `organization-total-private-repos`, Usage: ``},
 				cli.StringFlag{Name: `organization-type`, Usage: ``},
-				cli.StringFlag{Name: `organization-company`, Usage: ``},
-				cli.StringFlag{Name: `organization-blog`, Usage: ``},
-				cli.StringFlag{Name: `organization-email`, Usage: ``},
-				cli.IntFlag{Name: `organization-public-repos`, Usage: ``},
-				cli.IntFlag{Name: `organization-following`, Usage: ``},
-				cli.IntFlag{Name: `organization-owned-private-repos`, Usage: ``},
-				cli.StringFlag{Name: `organization-name`, Usage: ``},
-				cli.IntFlag{Name: `organization-collaborators`, Usage: ``},
-				cli.IntFlag{Name: `organization-followers`, Usage: ``},
-				cli.IntFlag{Name: `organization-private-gists`, Usage: ``},
-				cli.IntFlag{Name: `organization-disk-usage`, Usage: ``},
-				cli.StringFlag{Name: `organization-login`, Usage: ``},
-				cli.StringFlag{Name: `organization-billing-email`, Usage: ``},
-				cli.IntFlag{Name: `id`, Usage: ``},
-				cli.StringFlag{Name: `name`, Usage: ``},
+				cli.StringFlag{Name: `organization-updated-at`, Usage: ``},
+				cli.StringFlag{Name: `permission`, Usage: ``},
+				cli.IntFlag{Name: `repos-count`, Usage: ``},
+				cli.StringFlag{Name: `slug`, Usage: ``},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the Team, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 1 {
+				args := c.Args()
+				if len(args) < 1 {
 					showHelp(c, "edit-team", "edit-team <id>")
 				}
 
-				id, err := strconv.Atoi(c.Args().Get(0))
+				id, err := strconv.Atoi(args.Get(0))
 				check(err)
-				team := &github.Team{
-					MembersCount: github.Int(c.Int("members-count")),
-					ReposCount:   github.Int(c.Int("repos-count")),
-					ID:           github.Int(c.Int("id")),
-					Name:         github.String(c.String("name")),
-					Slug:         github.String(c.String("slug")),
-					Permission:   github.String(c.String("permission")),
+				team := &github.Team{}
+				check(decodeFile(c.String("body-file"), team))
+				if c.IsSet("id") {
+					team.ID = github.Int(c.Int("id"))
+				}
+				if c.IsSet("members-count") {
+					team.MembersCount = github.Int(c.Int("members-count"))
+				}
+				if c.IsSet("name") {
+					team.Name = github.String(c.String("name"))
+				}
+				if c.IsSet("permission") {
+					team.Permission = github.String(c.String("permission"))
+				}
+				if c.IsSet("repos-count") {
+					team.ReposCount = github.Int(c.Int("repos-count"))
+				}
+				if c.IsSet("slug") {
+					team.Slug = github.String(c.String("slug"))
 				}
 
 				result, res, err := app.gh.Organizations.EditTeam(id, team)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "Team", result)
 
 			},
 		}, cli.Command{
@@ -759,17 +902,22 @@ Possible values are: "active", "pending"`},
 			Description: `delete-team deletes a team.
 
    GitHub API docs: http://developer.github.com/v3/orgs/teams/#delete-team`,
-			Flags: []cli.Flag{},
+			Flags: []cli.Flag{
+				cli.BoolFlag{Name: `yes`, Usage: `Don't ask for confirmation`},
+			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 1 {
+				args := c.Args()
+				if len(args) < 1 {
 					showHelp(c, "delete-team", "delete-team <team>")
 				}
 
-				team, err := strconv.Atoi(c.Args().Get(0))
+				team, err := strconv.Atoi(args.Get(0))
 				check(err)
 
+				confirm(c, "delete-team", strconv.Itoa(team))
+
 				res, err := app.gh.Organizations.DeleteTeam(team)
-				checkResponse(res.Response, err)
+				checkResponse(res, err)
 
 			},
 		}, cli.Command{
@@ -783,33 +931,35 @@ Possible values are: "active", "pending"`},
 				cli.IntFlag{Name: `page`, Usage: `For paginated result sets, page of results to retrieve.`},
 				cli.IntFlag{Name: `per-page`, Usage: `For paginated result sets, the number of results to include per page.`},
 				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
+				cli.IntFlag{Name: `limit`, Usage: `Stop after this many items, fetching the following pages as needed`},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the ListOptions, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 1 {
+				args := c.Args()
+				if len(args) < 1 {
 					showHelp(c, "list-team-members", "list-team-members <team>")
 				}
 
-				team, err := strconv.Atoi(c.Args().Get(0))
+				team, err := strconv.Atoi(args.Get(0))
 				check(err)
-				opt := &github.ListOptions{
-					Page:    c.Int("page"),
-					PerPage: c.Int("per-page"),
+				opt := &github.ListOptions{}
+				check(decodeFile(c.String("body-file"), opt))
+				if c.IsSet("page") {
+					opt.Page = c.Int("page")
+				}
+				if c.IsSet("per-page") {
+					opt.PerPage = c.Int("per-page")
 				}
 
-				var items []github.User
-
-				for {
-					page, res, err := app.gh.Organizations.ListTeamMembers(team, opt)
-					checkResponse(res.Response, err)
-
-					items = append(items, page...)
-					if res.NextPage == 0 || !c.Bool("all") {
-						break
-					}
-					opt.Page = res.NextPage
+				if opt.PerPage == 0 {
+					opt.PerPage = app.profile.PerPage
 				}
 
-				fmt.Printf("%# v", pretty.Formatter(items))
+				paginate(c, "User", func(page int) (interface{}, *github.Response, error) {
+					opt := *opt
+					opt.Page = page
+					return app.gh.Organizations.ListTeamMembers(team, &opt)
+				})
 			},
 		}, cli.Command{
 			Name:  "is-team-member",
@@ -819,17 +969,18 @@ Possible values are: "active", "pending"`},
    GitHub API docs: http://developer.github.com/v3/orgs/teams/#get-team-member`,
 			Flags: []cli.Flag{},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 2 {
+				args := c.Args()
+				if len(args) < 2 {
 					showHelp(c, "is-team-member", "is-team-member <team> <user>")
 				}
 
-				team, err := strconv.Atoi(c.Args().Get(0))
+				team, err := strconv.Atoi(args.Get(0))
 				check(err)
-				user := c.Args().Get(1)
+				user := args.Get(1)
 
 				result, res, err := app.gh.Organizations.IsTeamMember(team, user)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "bool", result)
 
 			},
 		}, cli.Command{
@@ -839,36 +990,38 @@ Possible values are: "active", "pending"`},
 
    GitHub API docs: http://developer.github.com/v3/orgs/teams/#list-team-repos`,
 			Flags: []cli.Flag{
-				cli.IntFlag{Name: `per-page`, Usage: `For paginated result sets, the number of results to include per page.`},
 				cli.IntFlag{Name: `page`, Usage: `For paginated result sets, page of results to retrieve.`},
+				cli.IntFlag{Name: `per-page`, Usage: `For paginated result sets, the number of results to include per page.`},
 				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
+				cli.IntFlag{Name: `limit`, Usage: `Stop after this many items, fetching the following pages as needed`},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the ListOptions, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 1 {
+				args := c.Args()
+				if len(args) < 1 {
 					showHelp(c, "list-team-repos", "list-team-repos <team>")
 				}
 
-				team, err := strconv.Atoi(c.Args().Get(0))
+				team, err := strconv.Atoi(args.Get(0))
 				check(err)
-				opt := &github.ListOptions{
-					Page:    c.Int("page"),
-					PerPage: c.Int("per-page"),
+				opt := &github.ListOptions{}
+				check(decodeFile(c.String("body-file"), opt))
+				if c.IsSet("page") {
+					opt.Page = c.Int("page")
+				}
+				if c.IsSet("per-page") {
+					opt.PerPage = c.Int("per-page")
 				}
 
-				var items []github.Repository
-
-				for {
-					page, res, err := app.gh.Organizations.ListTeamRepos(team, opt)
-					checkResponse(res.Response, err)
-
-					items = append(items, page...)
-					if res.NextPage == 0 || !c.Bool("all") {
-						break
-					}
-					opt.Page = res.NextPage
+				if opt.PerPage == 0 {
+					opt.PerPage = app.profile.PerPage
 				}
 
-				fmt.Printf("%# v", pretty.Formatter(items))
+				paginate(c, "Repository", func(page int) (interface{}, *github.Response, error) {
+					opt := *opt
+					opt.Page = page
+					return app.gh.Organizations.ListTeamRepos(team, &opt)
+				})
 			},
 		}, cli.Command{
 			Name:  "is-team-repo",
@@ -878,18 +1031,19 @@ Possible values are: "active", "pending"`},
    GitHub API docs: http://developer.github.com/v3/orgs/teams/#get-team-repo`,
 			Flags: []cli.Flag{},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 3 {
+				args := c.Args()
+				if len(args) < 3 {
 					showHelp(c, "is-team-repo", "is-team-repo <team> <owner> <repo>")
 				}
 
-				team, err := strconv.Atoi(c.Args().Get(0))
+				team, err := strconv.Atoi(args.Get(0))
 				check(err)
-				owner := c.Args().Get(1)
-				repo := c.Args().Get(2)
+				owner := args.Get(1)
+				repo := args.Get(2)
 
 				result, res, err := app.gh.Organizations.IsTeamRepo(team, owner, repo)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "bool", result)
 
 			},
 		}, cli.Command{
@@ -902,17 +1056,18 @@ Possible values are: "active", "pending"`},
    GitHub API docs: http://developer.github.com/v3/orgs/teams/#add-team-repo`,
 			Flags: []cli.Flag{},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 3 {
+				args := c.Args()
+				if len(args) < 3 {
 					showHelp(c, "add-team-repo", "add-team-repo <team> <owner> <repo>")
 				}
 
-				team, err := strconv.Atoi(c.Args().Get(0))
+				team, err := strconv.Atoi(args.Get(0))
 				check(err)
-				owner := c.Args().Get(1)
-				repo := c.Args().Get(2)
+				owner := args.Get(1)
+				repo := args.Get(2)
 
 				res, err := app.gh.Organizations.AddTeamRepo(team, owner, repo)
-				checkResponse(res.Response, err)
+				checkResponse(res, err)
 
 			},
 		}, cli.Command{
@@ -923,19 +1078,24 @@ Possible values are: "active", "pending"`},
    from the team.
 
    GitHub API docs: http://developer.github.com/v3/orgs/teams/#remove-team-repo`,
-			Flags: []cli.Flag{},
+			Flags: []cli.Flag{
+				cli.BoolFlag{Name: `yes`, Usage: `Don't ask for confirmation`},
+			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 3 {
+				args := c.Args()
+				if len(args) < 3 {
 					showHelp(c, "remove-team-repo", "remove-team-repo <team> <owner> <repo>")
 				}
 
-				team, err := strconv.Atoi(c.Args().Get(0))
+				team, err := strconv.Atoi(args.Get(0))
 				check(err)
-				owner := c.Args().Get(1)
-				repo := c.Args().Get(2)
+				owner := args.Get(1)
+				repo := args.Get(2)
+
+				confirm(c, "remove-team-repo", repo)
 
 				res, err := app.gh.Organizations.RemoveTeamRepo(team, owner, repo)
-				checkResponse(res.Response, err)
+				checkResponse(res, err)
 
 			},
 		}, cli.Command{
@@ -947,27 +1107,28 @@ Possible values are: "active", "pending"`},
 				cli.IntFlag{Name: `page`, Usage: `For paginated result sets, page of results to retrieve.`},
 				cli.IntFlag{Name: `per-page`, Usage: `For paginated result sets, the number of results to include per page.`},
 				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
+				cli.IntFlag{Name: `limit`, Usage: `Stop after this many items, fetching the following pages as needed`},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the ListOptions, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				opt := &github.ListOptions{
-					PerPage: c.Int("per-page"),
-					Page:    c.Int("page"),
+				opt := &github.ListOptions{}
+				check(decodeFile(c.String("body-file"), opt))
+				if c.IsSet("page") {
+					opt.Page = c.Int("page")
+				}
+				if c.IsSet("per-page") {
+					opt.PerPage = c.Int("per-page")
 				}
 
-				var items []github.Team
-
-				for {
-					page, res, err := app.gh.Organizations.ListUserTeams(opt)
-					checkResponse(res.Response, err)
-
-					items = append(items, page...)
-					if res.NextPage == 0 || !c.Bool("all") {
-						break
-					}
-					opt.Page = res.NextPage
+				if opt.PerPage == 0 {
+					opt.PerPage = app.profile.PerPage
 				}
 
-				fmt.Printf("%# v", pretty.Formatter(items))
+				paginate(c, "Team", func(page int) (interface{}, *github.Response, error) {
+					opt := *opt
+					opt.Page = page
+					return app.gh.Organizations.ListUserTeams(&opt)
+				})
 			},
 		}, cli.Command{
 			Name:  "get-team-membership",
@@ -977,17 +1138,18 @@ Possible values are: "active", "pending"`},
    GitHub API docs: https://developer.github.com/v3/orgs/teams/#get-team-membership`,
 			Flags: []cli.Flag{},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 2 {
+				args := c.Args()
+				if len(args) < 2 {
 					showHelp(c, "get-team-membership", "get-team-membership <team> <user>")
 				}
 
-				team, err := strconv.Atoi(c.Args().Get(0))
+				team, err := strconv.Atoi(args.Get(0))
 				check(err)
-				user := c.Args().Get(1)
+				user := args.Get(1)
 
 				result, res, err := app.gh.Organizations.GetTeamMembership(team, user)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "Membership", result)
 
 			},
 		}, cli.Command{
@@ -1013,17 +1175,18 @@ Possible values are: "active", "pending"`},
    GitHub API docs: https://developer.github.com/v3/orgs/teams/#add-team-membership`,
 			Flags: []cli.Flag{},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 2 {
+				args := c.Args()
+				if len(args) < 2 {
 					showHelp(c, "add-team-membership", "add-team-membership <team> <user>")
 				}
 
-				team, err := strconv.Atoi(c.Args().Get(0))
+				team, err := strconv.Atoi(args.Get(0))
 				check(err)
-				user := c.Args().Get(1)
+				user := args.Get(1)
 
 				result, res, err := app.gh.Organizations.AddTeamMembership(team, user)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "Membership", result)
 
 			},
 		}, cli.Command{
@@ -1032,18 +1195,23 @@ Possible values are: "active", "pending"`},
 			Description: `remove-team-membership removes a user from a team.
 
    GitHub API docs: https://developer.github.com/v3/orgs/teams/#remove-team-membership`,
-			Flags: []cli.Flag{},
+			Flags: []cli.Flag{
+				cli.BoolFlag{Name: `yes`, Usage: `Don't ask for confirmation`},
+			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 2 {
+				args := c.Args()
+				if len(args) < 2 {
 					showHelp(c, "remove-team-membership", "remove-team-membership <team> <user>")
 				}
 
-				team, err := strconv.Atoi(c.Args().Get(0))
+				team, err := strconv.Atoi(args.Get(0))
 				check(err)
-				user := c.Args().Get(1)
+				user := args.Get(1)
+
+				confirm(c, "remove-team-membership", user)
 
 				res, err := app.gh.Organizations.RemoveTeamMembership(team, user)
-				checkResponse(res.Response, err)
+				checkResponse(res, err)
 
 			},
 		},
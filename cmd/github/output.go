@@ -0,0 +1,162 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strings"
+	"text/template"
+
+	"github.com/codegangsta/cli"
+	"github.com/kr/pretty"
+	"gopkg.in/yaml.v2"
+)
+
+// render writes the result of a command to stdout, in the format selected
+// with the global --output flag, or through the --template one when given.
+// typeName is the go-github type of the result, used to pick default columns
+// in table mode.
+func render(c *cli.Context, typeName string, v interface{}) {
+	if text := c.GlobalString("template"); text != "" {
+		check(renderTemplate(os.Stdout, text, v))
+		return
+	}
+
+	switch format := app.profile.Output; format {
+	case "json":
+		out, err := json.MarshalIndent(v, "", "  ")
+		check(err)
+		fmt.Println(string(out))
+	case "yaml":
+		out, err := toYAML(v)
+		check(err)
+		fmt.Print(string(out))
+	case "table":
+		check(renderTable(os.Stdout, typeName, v, tableFields(c)))
+	case "pretty", "":
+		fmt.Printf("%# v", pretty.Formatter(v))
+	default:
+		exit(fmt.Errorf("Unknown output format %q", format))
+	}
+}
+
+func tableFields(c *cli.Context) []string {
+	if c.GlobalString("fields") == "" {
+		return nil
+	}
+	return strings.Split(c.GlobalString("fields"), ",")
+}
+
+// listWriter renders the items of a list command page by page, as they are
+// fetched, in the same formats as render. Table output is the exception: rows
+// are buffered until close so that columns can be aligned.
+type listWriter struct {
+	c        *cli.Context
+	w        io.Writer
+	typeName string
+	tmpl     *template.Template
+	limit    int
+	count    int
+	rows     reflect.Value
+}
+
+func newListWriter(c *cli.Context, typeName string) *listWriter {
+	lw := &listWriter{c: c, w: os.Stdout, typeName: typeName, limit: c.Int("limit")}
+
+	if text := c.GlobalString("template"); text != "" {
+		tmpl, err := parseOutputTemplate(text)
+		check(err)
+		lw.tmpl = tmpl
+	}
+
+	return lw
+}
+
+// write outputs the items of page, a slice, and reports whether more are
+// wanted, i.e. --limit hasn't been reached.
+func (lw *listWriter) write(page interface{}) bool {
+	items := reflect.ValueOf(page)
+	for i := 0; i < items.Len(); i++ {
+		if lw.limit > 0 && lw.count >= lw.limit {
+			return false
+		}
+		lw.writeItem(items.Index(i))
+		lw.count++
+	}
+
+	return lw.limit <= 0 || lw.count < lw.limit
+}
+
+func (lw *listWriter) writeItem(item reflect.Value) {
+	if lw.tmpl != nil {
+		check(executeLine(lw.w, lw.tmpl, item.Interface()))
+		return
+	}
+
+	switch format := app.profile.Output; format {
+	case "json":
+		out, err := json.MarshalIndent(item.Interface(), "  ", "  ")
+		check(err)
+		if lw.count == 0 {
+			fmt.Fprint(lw.w, "[\n  ")
+		} else {
+			fmt.Fprint(lw.w, ",\n  ")
+		}
+		lw.w.Write(out)
+	case "yaml":
+		out, err := toYAML([]interface{}{item.Interface()})
+		check(err)
+		lw.w.Write(out)
+	case "table":
+		if !lw.rows.IsValid() {
+			lw.rows = reflect.MakeSlice(reflect.SliceOf(item.Type()), 0, 0)
+		}
+		lw.rows = reflect.Append(lw.rows, item)
+	case "pretty", "":
+		fmt.Fprintf(lw.w, "%# v\n", pretty.Formatter(item.Interface()))
+	default:
+		exit(fmt.Errorf("Unknown output format %q", format))
+	}
+}
+
+// close terminates the output, and renders the table rows if any
+func (lw *listWriter) close() {
+	if lw.tmpl != nil {
+		return
+	}
+
+	switch app.profile.Output {
+	case "json":
+		if lw.count == 0 {
+			fmt.Fprintln(lw.w, "[]")
+		} else {
+			fmt.Fprintln(lw.w, "\n]")
+		}
+	case "yaml":
+		if lw.count == 0 {
+			fmt.Fprintln(lw.w, "[]")
+		}
+	case "table":
+		if lw.rows.IsValid() {
+			check(renderTable(lw.w, lw.typeName, lw.rows.Interface(), tableFields(lw.c)))
+		}
+	}
+}
+
+// toYAML goes through JSON first so the go-github json tags are used as keys,
+// and nil fields are omitted the same way they are in json mode.
+func toYAML(v interface{}) ([]byte, error) {
+	j, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var generic interface{}
+	if err := yaml.Unmarshal(j, &generic); err != nil {
+		return nil, err
+	}
+
+	return yaml.Marshal(generic)
+}
@@ -0,0 +1,189 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"sync"
+
+	"github.com/codegangsta/cli"
+	"github.com/google/go-github/github"
+)
+
+// Maximum number of pages fetched at the same time with --all
+const pageWorkers = 4
+
+// pageFetcher returns the items of the given page of a list, as a slice
+type pageFetcher func(page int) (interface{}, *github.Response, error)
+
+type pageResult struct {
+	items interface{}
+	res   *github.Response
+	err   error
+}
+
+// paginate fetches the page selected with --page, or all the following ones
+// with --all, and streams their items to the output in order. When the Link
+// header tells where the last page is, pages are fetched concurrently,
+// otherwise next links are followed one after another. Fetching stops as soon
+// as --limit items have been written, and goes on to the following pages until
+// then even without --all.
+func paginate(c *cli.Context, typeName string, fetch pageFetcher) {
+	out := newListWriter(c, typeName)
+	err := fetchPages(out, c.Int("page"), c.Bool("all"), fetch)
+	// Closed before exiting on a failed request, so that the items already
+	// written stay valid, e.g. a JSON array
+	out.close()
+	check(err)
+}
+
+// fetchPages writes the items of page first to out, then those of the
+// following pages with all or a limit, and returns the first failed request.
+func fetchPages(out *listWriter, first int, all bool, fetch pageFetcher) error {
+	if first < 1 {
+		first = 1
+	}
+
+	items, res, err := fetch(first)
+	if err := pageError(res, err); err != nil {
+		return err
+	}
+	if !out.write(items) || (!all && out.limit <= 0) {
+		return nil
+	}
+
+	if res.LastPage == 0 || !all {
+		for res.NextPage != 0 {
+			items, res, err = fetch(res.NextPage)
+			if err := pageError(res, err); err != nil {
+				return err
+			}
+			if !out.write(items) {
+				break
+			}
+		}
+		return nil
+	}
+
+	return fetchConcurrently(first+1, res.LastPage, fetch, out.write)
+}
+
+// pageError is the error of a page request, a failure status included, like
+// checkResponse checks.
+func pageError(res *github.Response, err error) error {
+	if err == nil && res != nil {
+		err = checkStatus(res.Response)
+	}
+	return err
+}
+
+// fetchConcurrently fetches pages first to last with at most pageWorkers
+// requests in flight, and hands them to write in order until it returns false.
+// Pages are only requested pageWorkers ahead of the one being written, which
+// bounds memory use as well. The first failed request stops it and is
+// returned.
+func fetchConcurrently(first, last int, fetch pageFetcher, write func(interface{}) bool) error {
+	results := make(map[int]chan pageResult)
+	for page := first; page <= last; page++ {
+		results[page] = make(chan pageResult, 1)
+	}
+
+	window := make(chan struct{}, pageWorkers)
+	stop := make(chan struct{})
+	defer close(stop)
+
+	jobs := make(chan int)
+	go func() {
+		defer close(jobs)
+		for page := first; page <= last; page++ {
+			select {
+			case window <- struct{}{}:
+				jobs <- page
+			case <-stop:
+				return
+			}
+		}
+	}()
+
+	for i := 0; i < pageWorkers; i++ {
+		go func() {
+			for page := range jobs {
+				items, res, err := fetch(page)
+				results[page] <- pageResult{items, res, err}
+			}
+		}()
+	}
+
+	for page := first; page <= last; page++ {
+		r := <-results[page]
+		if err := pageError(r.res, r.err); err != nil {
+			return err
+		}
+		<-window
+		if !write(r.items) {
+			return nil
+		}
+	}
+	return nil
+}
+
+// The search API only gives access to the first 1000 results of a query
+const maxSearchResults = 1000
+
+// paginateSearch pages through search results the same way paginate does for
+// lists. fetch returns a whole search result, whose itemsField holds the items
+// of the page. The total count, and whether the search timed out before
+// finding all matches, are reported on stderr.
+func paginateSearch(c *cli.Context, typeName, itemsField string, fetch pageFetcher) {
+	perPage := c.Int("per-page")
+	if perPage <= 0 {
+		perPage = app.profile.PerPage
+	}
+	if perPage <= 0 {
+		perPage = 30
+	}
+	lastPage := (maxSearchResults + perPage - 1) / perPage
+
+	var once sync.Once
+	paginate(c, typeName, func(page int) (interface{}, *github.Response, error) {
+		result, res, err := fetch(page)
+		if err != nil {
+			return nil, res, err
+		}
+
+		if res.LastPage > lastPage {
+			res.LastPage = lastPage
+		}
+		if page >= lastPage {
+			res.NextPage = 0
+		}
+
+		v := reflect.Indirect(reflect.ValueOf(result))
+		once.Do(func() { reportSearch(v) })
+		return v.FieldByName(itemsField).Interface(), res, nil
+	})
+}
+
+func reportSearch(result reflect.Value) {
+	var total int64
+	var incomplete bool
+	if i := fieldIndex(result.Type(), "total_count"); i >= 0 {
+		if f := reflect.Indirect(result.Field(i)); f.IsValid() {
+			total = f.Int()
+		}
+	}
+	if i := fieldIndex(result.Type(), "incomplete_results"); i >= 0 {
+		if f := reflect.Indirect(result.Field(i)); f.IsValid() {
+			incomplete = f.Bool()
+		}
+	}
+
+	fmt.Fprintf(os.Stderr, "%d results", total)
+	if total > maxSearchResults {
+		fmt.Fprintf(os.Stderr, ", only the first %d can be fetched", maxSearchResults)
+	}
+	if incomplete {
+		fmt.Fprint(os.Stderr, ", incomplete as the search timed out")
+	}
+	fmt.Fprintln(os.Stderr)
+}
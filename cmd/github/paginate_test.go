@@ -0,0 +1,197 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/go-github/github"
+)
+
+const itemsPerPage = 3
+
+// pagedServer serves pages of itemsPerPage consecutive numbers, with Link
+// headers telling the next page, and the last one when withLast is set.
+// failPage, if any, fails with a 500.
+type pagedServer struct {
+	*httptest.Server
+	pages    int
+	withLast bool
+	failPage int
+
+	mu        sync.Mutex
+	requested []int
+}
+
+func newPagedServer(pages int, withLast bool, failPage int) *pagedServer {
+	s := &pagedServer{pages: pages, withLast: withLast, failPage: failPage}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serve))
+	return s
+}
+
+func (s *pagedServer) serve(w http.ResponseWriter, r *http.Request) {
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	s.mu.Lock()
+	s.requested = append(s.requested, page)
+	s.mu.Unlock()
+
+	if page == s.failPage {
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprint(w, `{"message": "Server Error"}`)
+		return
+	}
+
+	var links []string
+	if page < s.pages {
+		links = append(links, fmt.Sprintf(`<%s/items?page=%d>; rel="next"`, s.URL, page+1))
+		if s.withLast {
+			links = append(links, fmt.Sprintf(`<%s/items?page=%d>; rel="last"`, s.URL, s.pages))
+		}
+	}
+	if len(links) > 0 {
+		w.Header().Set("Link", strings.Join(links, ", "))
+	}
+
+	var items []int
+	for i := 0; i < itemsPerPage; i++ {
+		items = append(items, (page-1)*itemsPerPage+i+1)
+	}
+	json.NewEncoder(w).Encode(items)
+}
+
+func (s *pagedServer) requestedPages() []int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	pages := append([]int(nil), s.requested...)
+	sort.Ints(pages)
+	return pages
+}
+
+func (s *pagedServer) fetch(page int) (interface{}, *github.Response, error) {
+	client := github.NewClient(nil)
+	client.BaseURL, _ = url.Parse(s.URL + "/")
+	req, err := client.NewRequest("GET", fmt.Sprintf("items?page=%d", page), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	var items []int
+	res, err := client.Do(req, &items)
+	return items, res, err
+}
+
+func numbers(first, last int) []int {
+	var n []int
+	for i := first; i <= last; i++ {
+		n = append(n, i)
+	}
+	return n
+}
+
+func TestFetchPages(t *testing.T) {
+	output := app.profile.Output
+	app.profile.Output = "json"
+	defer func() { app.profile.Output = output }()
+
+	tests := []struct {
+		name     string
+		withLast bool
+		failPage int
+		all      bool
+		limit    int
+		want     []int
+		pages    []int
+		fails    bool
+	}{
+		{
+			name:  "first page only",
+			want:  numbers(1, 3),
+			pages: []int{1},
+		},
+		{
+			name:     "all with last link",
+			withLast: true,
+			all:      true,
+			want:     numbers(1, 15),
+			pages:    numbers(1, 5),
+		},
+		{
+			name:  "all following next links",
+			all:   true,
+			want:  numbers(1, 15),
+			pages: numbers(1, 5),
+		},
+		{
+			name:     "limit without all stops mid-page",
+			withLast: true,
+			limit:    7,
+			want:     numbers(1, 7),
+			pages:    numbers(1, 3),
+		},
+		{
+			name:     "limit with all fetching concurrently",
+			withLast: true,
+			all:      true,
+			limit:    7,
+			want:     numbers(1, 7),
+		},
+		{
+			name:     "limit within the first page",
+			withLast: true,
+			all:      true,
+			limit:    2,
+			want:     numbers(1, 2),
+			pages:    []int{1},
+		},
+		{
+			name:     "failing middle page",
+			withLast: true,
+			failPage: 3,
+			all:      true,
+			want:     numbers(1, 6),
+			fails:    true,
+		},
+		{
+			name:     "failing middle page following next links",
+			failPage: 3,
+			all:      true,
+			want:     numbers(1, 6),
+			pages:    numbers(1, 3),
+			fails:    true,
+		},
+	}
+
+	for _, test := range tests {
+		server := newPagedServer(5, test.withLast, test.failPage)
+
+		var buf bytes.Buffer
+		out := &listWriter{w: &buf, limit: test.limit}
+		err := fetchPages(out, 1, test.all, server.fetch)
+		out.close()
+		server.Close()
+
+		if test.fails != (err != nil) {
+			t.Errorf("%s: got error %v", test.name, err)
+		}
+		var got []int
+		if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+			t.Errorf("%s: invalid JSON output %q: %v", test.name, buf.String(), err)
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: got items %v, want %v", test.name, got, test.want)
+		}
+		// Left out when concurrent fetching may request pages past the one
+		// that stops it
+		if test.pages != nil && !reflect.DeepEqual(server.requestedPages(), test.pages) {
+			t.Errorf("%s: requested pages %v, want %v", test.name, server.requestedPages(), test.pages)
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/codegangsta/cli"
+	"github.com/google/go-github/github"
+)
+
+// rateLimitTransport retries requests rejected because the rate limit is
+// exhausted, or because abuse detection kicked in, once the limit resets. It
+// only waits when enabled with --wait-on-rate-limit, never longer than
+// --max-wait, and not past the end of the context of the request. sleep does
+// the waiting.
+type rateLimitTransport struct {
+	base    http.RoundTripper
+	wait    bool
+	maxWait time.Duration
+	sleep   func(context.Context, time.Duration) error
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !t.wait {
+		return t.base.RoundTrip(req)
+	}
+
+	body, err := readBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		res, err := t.base.RoundTrip(withBody(req, body))
+		if err != nil {
+			return res, err
+		}
+
+		delay, limited := rateLimitDelay(res, time.Now())
+		if !limited || delay > t.maxWait {
+			return res, nil
+		}
+
+		res.Body.Close()
+		fmt.Fprintf(os.Stderr, "Rate limit exceeded, waiting %s\n", delay)
+		if err := t.sleep(req.Context(), delay); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// rateLimitDelay tells whether res was rejected by rate limiting, and how long
+// to wait before trying again, from either Retry-After for abuse detection or
+// X-RateLimit-Reset when no requests remain.
+func rateLimitDelay(res *http.Response, now time.Time) (time.Duration, bool) {
+	if res.StatusCode != http.StatusForbidden && res.StatusCode != 429 {
+		return 0, false
+	}
+
+	if after := res.Header.Get("Retry-After"); after != "" {
+		seconds, err := strconv.Atoi(after)
+		if err != nil {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if res.Header.Get("X-RateLimit-Remaining") != "0" {
+		return 0, false
+	}
+	reset, err := strconv.ParseInt(res.Header.Get("X-RateLimit-Reset"), 10, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	// Leave a second of margin for clock skew
+	delay := time.Unix(reset, 0).Sub(now) + time.Second
+	if delay < time.Second {
+		delay = time.Second
+	}
+	return delay, true
+}
+
+var rateLimitCommand = cli.Command{
+	Name:  "rate-limit",
+	Usage: "show the core and search API quotas",
+	Action: func(c *cli.Context) {
+		limits, res, err := app.gh.RateLimits()
+		checkResponse(res, err)
+
+		if c.GlobalIsSet("output") || c.GlobalString("template") != "" {
+			render(c, "RateLimits", limits)
+			return
+		}
+
+		printRate("core", limits.Core)
+		printRate("search", limits.Search)
+	},
+}
+
+func printRate(name string, rate *github.Rate) {
+	if rate == nil {
+		return
+	}
+	wait := rate.Reset.Sub(time.Now()) / time.Second * time.Second
+	fmt.Printf("%-7s %d/%d remaining, resets at %s (in %s)\n",
+		name+":", rate.Remaining, rate.Limit, rate.Reset.Format("15:04:05"), wait)
+}
+
+func init() {
+	app.cli.Commands = append(app.cli.Commands, rateLimitCommand)
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestRateLimitDelay(t *testing.T) {
+	now := time.Unix(1434000000, 0)
+	reset := strconv.FormatInt(now.Add(time.Minute).Unix(), 10)
+	past := strconv.FormatInt(now.Add(-time.Minute).Unix(), 10)
+
+	tests := []struct {
+		name    string
+		status  int
+		header  map[string]string
+		delay   time.Duration
+		limited bool
+	}{
+		{"ok", 200, map[string]string{"X-RateLimit-Remaining": "0", "X-RateLimit-Reset": reset}, 0, false},
+		{"forbidden", 403, map[string]string{"X-RateLimit-Remaining": "10", "X-RateLimit-Reset": reset}, 0, false},
+		{"exhausted", 403, map[string]string{"X-RateLimit-Remaining": "0", "X-RateLimit-Reset": reset}, time.Minute + time.Second, true},
+		{"reset passed", 403, map[string]string{"X-RateLimit-Remaining": "0", "X-RateLimit-Reset": past}, time.Second, true},
+		{"no reset", 403, map[string]string{"X-RateLimit-Remaining": "0"}, 0, false},
+		{"abuse", 403, map[string]string{"Retry-After": "30"}, 30 * time.Second, true},
+		{"too many requests", 429, map[string]string{"Retry-After": "5"}, 5 * time.Second, true},
+		{"invalid retry after", 429, map[string]string{"Retry-After": "soon"}, 0, false},
+	}
+
+	for _, test := range tests {
+		res := &http.Response{StatusCode: test.status, Header: http.Header{}}
+		for k, v := range test.header {
+			res.Header.Set(k, v)
+		}
+
+		delay, limited := rateLimitDelay(res, now)
+		if delay != test.delay || limited != test.limited {
+			t.Errorf("%s: rateLimitDelay = %s, %t, want %s, %t", test.name, delay, limited, test.delay, test.limited)
+		}
+	}
+}
+
+// TestRateLimitTimeout checks that a client timeout ends the wait for a rate
+// limit reset.
+func TestRateLimitTimeout(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(429)
+	}))
+	defer srv.Close()
+
+	client := &http.Client{
+		Timeout:   100 * time.Millisecond,
+		Transport: &rateLimitTransport{base: http.DefaultTransport, wait: true, maxWait: time.Hour, sleep: sleepContext},
+	}
+	start := time.Now()
+	_, err := client.Get(srv.URL)
+	if err == nil {
+		t.Fatal("got no error")
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("returned after %s, want about the 100ms timeout", elapsed)
+	}
+}
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"strings"
+
+	"github.com/codegangsta/cli"
+)
+
+// repoArgs returns the arguments of a command whose first two are an owner and
+// a repository. When only the required arguments are given, the owner and
+// repository are taken from the remote of the git checkout, if it is on the API
+// host, or else from the profile. Otherwise, the arguments are returned as is
+// for the command to check.
+func repoArgs(c *cli.Context, required int) cli.Args {
+	args := c.Args()
+	if len(args) != required {
+		return args
+	}
+
+	// A checkout of another host, e.g. of github.com while --api-url points
+	// to GitHub Enterprise, tells nothing about the repository wanted
+	host, owner, repo, err := gitRepository(c.GlobalString("remote"))
+	if err != nil || !strings.EqualFold(host, stripPort(webURL(app.gh.BaseURL).Host)) {
+		owner, repo = app.profile.Owner, app.profile.Repo
+	}
+	if owner == "" || repo == "" {
+		return args
+	}
+	return append(cli.Args{owner, repo}, args...)
+}
@@ -1,14 +1,11 @@
 package main
 
 import (
-	"fmt"
 	"os"
 	"strconv"
 
 	"github.com/codegangsta/cli"
 	"github.com/google/go-github/github"
-	"github.com/jinzhu/now"
-	"github.com/kr/pretty"
 )
 
 var RepositoriesService = cli.Command{
@@ -24,46 +21,58 @@ var RepositoriesService = cli.Command{
 
    GitHub API docs: http://developer.github.com/v3/repos/#list-user-repositories`,
 			Flags: []cli.Flag{
-				cli.StringFlag{Name: `type`, Usage: `Type of repositories to list.  Possible values are: all, owner, public,
-private, member.  Default is "all".`},
-				cli.StringFlag{Name: `sort`, Usage: `How to sort the repository list.  Possible values are: created, updated,
-pushed, full_name.  Default is "full_name".`},
+				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
 				cli.StringFlag{Name: `direction`, Usage: `Direction in which to sort repositories.  Possible values are: asc, desc.
 Default is "asc" when sort is "full_name", otherwise default is "desc".`},
 				cli.BoolFlag{Name: `include-org`, Usage: `Include orginization repositories the user has access to.
 This will become the default behavior in the future, but is opt-in for now.
 See https://developer.github.com/changes/2015-01-07-prepare-for-organization-permissions-changes/`},
+				cli.IntFlag{Name: `limit`, Usage: `Stop after this many items, fetching the following pages as needed`},
 				cli.IntFlag{Name: `page`, Usage: `For paginated result sets, page of results to retrieve.`},
 				cli.IntFlag{Name: `per-page`, Usage: `For paginated result sets, the number of results to include per page.`},
-				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
+				cli.StringFlag{Name: `sort`, Usage: `How to sort the repository list.  Possible values are: created, updated,
+pushed, full_name.  Default is "full_name".`},
+				cli.StringFlag{Name: `type`, Usage: `Type of repositories to list.  Possible values are: all, owner, public,
+private, member.  Default is "all".`},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the RepositoryListOptions, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 1 {
+				args := c.Args()
+				if len(args) < 1 {
 					showHelp(c, "list", "list <user>")
 				}
 
-				user := c.Args().Get(0)
-				opt := &github.RepositoryListOptions{
-					Type:       c.String("type"),
-					Sort:       c.String("sort"),
-					Direction:  c.String("direction"),
-					IncludeOrg: c.Bool("include-org"),
+				user := args.Get(0)
+				opt := &github.RepositoryListOptions{}
+				check(decodeFile(c.String("body-file"), opt))
+				if c.IsSet("direction") {
+					opt.Direction = c.String("direction")
+				}
+				if c.IsSet("include-org") {
+					opt.IncludeOrg = c.Bool("include-org")
+				}
+				if c.IsSet("sort") {
+					opt.Sort = c.String("sort")
+				}
+				if c.IsSet("type") {
+					opt.Type = c.String("type")
+				}
+				if c.IsSet("page") {
+					opt.Page = c.Int("page")
+				}
+				if c.IsSet("per-page") {
+					opt.PerPage = c.Int("per-page")
 				}
 
-				var items []github.Repository
-
-				for {
-					page, res, err := app.gh.Repositories.List(user, opt)
-					checkResponse(res.Response, err)
-
-					items = append(items, page...)
-					if res.NextPage == 0 || !c.Bool("all") {
-						break
-					}
-					opt.Page = res.NextPage
+				if opt.PerPage == 0 {
+					opt.PerPage = app.profile.PerPage
 				}
 
-				fmt.Printf("%# v", pretty.Formatter(items))
+				paginate(c, "Repository", func(page int) (interface{}, *github.Response, error) {
+					opt := *opt
+					opt.Page = page
+					return app.gh.Repositories.List(user, &opt)
+				})
 			},
 		}, cli.Command{
 			Name:  "list-by-org",
@@ -72,36 +81,42 @@ See https://developer.github.com/changes/2015-01-07-prepare-for-organization-per
 
    GitHub API docs: http://developer.github.com/v3/repos/#list-organization-repositories`,
 			Flags: []cli.Flag{
-				cli.StringFlag{Name: `type`, Usage: `Type of repositories to list.  Possible values are: all, public, private,
-forks, sources, member.  Default is "all".`},
+				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
+				cli.IntFlag{Name: `limit`, Usage: `Stop after this many items, fetching the following pages as needed`},
 				cli.IntFlag{Name: `page`, Usage: `For paginated result sets, page of results to retrieve.`},
 				cli.IntFlag{Name: `per-page`, Usage: `For paginated result sets, the number of results to include per page.`},
-				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
+				cli.StringFlag{Name: `type`, Usage: `Type of repositories to list.  Possible values are: all, public, private,
+forks, sources, member.  Default is "all".`},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the RepositoryListByOrgOptions, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 1 {
+				args := c.Args()
+				if len(args) < 1 {
 					showHelp(c, "list-by-org", "list-by-org <org>")
 				}
 
-				org := c.Args().Get(0)
-				opt := &github.RepositoryListByOrgOptions{
-					Type: c.String("type"),
+				org := args.Get(0)
+				opt := &github.RepositoryListByOrgOptions{}
+				check(decodeFile(c.String("body-file"), opt))
+				if c.IsSet("type") {
+					opt.Type = c.String("type")
+				}
+				if c.IsSet("page") {
+					opt.Page = c.Int("page")
+				}
+				if c.IsSet("per-page") {
+					opt.PerPage = c.Int("per-page")
 				}
 
-				var items []github.Repository
-
-				for {
-					page, res, err := app.gh.Repositories.ListByOrg(org, opt)
-					checkResponse(res.Response, err)
-
-					items = append(items, page...)
-					if res.NextPage == 0 || !c.Bool("all") {
-						break
-					}
-					opt.Page = res.NextPage
+				if opt.PerPage == 0 {
+					opt.PerPage = app.profile.PerPage
 				}
 
-				fmt.Printf("%# v", pretty.Formatter(items))
+				paginate(c, "Repository", func(page int) (interface{}, *github.Response, error) {
+					opt := *opt
+					opt.Page = page
+					return app.gh.Repositories.ListByOrg(org, &opt)
+				})
 			},
 		}, cli.Command{
 			Name:  "list-all",
@@ -110,30 +125,35 @@ forks, sources, member.  Default is "all".`},
 
    GitHub API docs: http://developer.github.com/v3/repos/#list-all-public-repositories`,
 			Flags: []cli.Flag{
-				cli.IntFlag{Name: `since`, Usage: `ID of the last repository seen`},
+				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
+				cli.IntFlag{Name: `limit`, Usage: `Stop after this many items, fetching the following pages as needed`},
 				cli.IntFlag{Name: `page`, Usage: `For paginated result sets, page of results to retrieve.`},
 				cli.IntFlag{Name: `per-page`, Usage: `For paginated result sets, the number of results to include per page.`},
-				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
+				cli.IntFlag{Name: `since`, Usage: `ID of the last repository seen`},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the RepositoryListAllOptions, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				opt := &github.RepositoryListAllOptions{
-					Since: c.Int("since"),
+				opt := &github.RepositoryListAllOptions{}
+				check(decodeFile(c.String("body-file"), opt))
+				if c.IsSet("since") {
+					opt.Since = c.Int("since")
+				}
+				if c.IsSet("page") {
+					opt.Page = c.Int("page")
+				}
+				if c.IsSet("per-page") {
+					opt.PerPage = c.Int("per-page")
 				}
 
-				var items []github.Repository
-
-				for {
-					page, res, err := app.gh.Repositories.ListAll(opt)
-					checkResponse(res.Response, err)
-
-					items = append(items, page...)
-					if res.NextPage == 0 || !c.Bool("all") {
-						break
-					}
-					opt.Page = res.NextPage
+				if opt.PerPage == 0 {
+					opt.PerPage = app.profile.PerPage
 				}
 
-				fmt.Printf("%# v", pretty.Formatter(items))
+				paginate(c, "Repository", func(page int) (interface{}, *github.Response, error) {
+					opt := *opt
+					opt.Page = page
+					return app.gh.Repositories.ListAll(&opt)
+				})
 			},
 		}, cli.Command{
 			Name:  "create",
@@ -144,130 +164,182 @@ forks, sources, member.  Default is "all".`},
 
    GitHub API docs: http://developer.github.com/v3/repos/#create`,
 			Flags: []cli.Flag{
+				cli.BoolFlag{Name: `auto-init`, Usage: ``},
+				cli.StringFlag{Name: `created-at`, Usage: ``},
+				cli.StringFlag{Name: `default-branch`, Usage: ``},
+				cli.StringFlag{Name: `description`, Usage: ``},
+				cli.BoolFlag{Name: `fork`, Usage: ``},
+				cli.IntFlag{Name: `forks-count`, Usage: ``},
 				cli.StringFlag{Name: `full-name`, Usage: ``},
 				cli.BoolFlag{Name: `has-downloads`, Usage: ``},
-				cli.IntFlag{Name: `network-count`, Usage: ``},
+				cli.BoolFlag{Name: `has-issues`, Usage: ``},
+				cli.BoolFlag{Name: `has-wiki`, Usage: ``},
+				cli.StringFlag{Name: `homepage`, Usage: ``},
 				cli.IntFlag{Name: `id`, Usage: ``},
+				cli.StringFlag{Name: `language`, Usage: ``},
+				cli.StringFlag{Name: `license-body`, Usage: ``},
+				cli.StringFlag{Name: `license-category`, Usage: ``},
+				cli.StringFlag{Name: `license-description`, Usage: ``},
+				cli.BoolFlag{Name: `license-featured`, Usage: ``},
+				cli.StringSliceFlag{Name: `license-forbidden`, Usage: ``},
+				cli.StringFlag{Name: `license-implementation`, Usage: ``},
+				cli.StringFlag{Name: `license-key`, Usage: ``},
+				cli.StringFlag{Name: `license-name`, Usage: ``},
+				cli.StringSliceFlag{Name: `license-permitted`, Usage: ``},
+				cli.StringSliceFlag{Name: `license-required`, Usage: ``},
+				cli.StringFlag{Name: `master-branch`, Usage: ``},
+				cli.StringFlag{Name: `name`, Usage: ``},
+				cli.IntFlag{Name: `network-count`, Usage: ``},
 				cli.IntFlag{Name: `open-issues-count`, Usage: ``},
+				cli.StringFlag{Name: `organization-billing-email`, Usage: ``},
+				cli.StringFlag{Name: `organization-blog`, Usage: ``},
+				cli.IntFlag{Name: `organization-collaborators`, Usage: ``},
+				cli.StringFlag{Name: `organization-company`, Usage: ``},
 				cli.StringFlag{Name: `organization-created-at`, Usage: ``},
-				cli.StringFlag{Name: `organization-plan-name`, Usage: ``},
-				cli.IntFlag{Name: `organization-plan-space`, Usage: ``},
-				cli.IntFlag{Name: `organization-plan-collaborators`, Usage: ``},
-				cli.IntFlag{Name: `organization-plan-private-repos`, Usage: ``},
+				cli.IntFlag{Name: `organization-disk-usage`, Usage: ``},
+				cli.StringFlag{Name: `organization-email`, Usage: ``},
+				cli.IntFlag{Name: `organization-followers`, Usage: ``},
+				cli.IntFlag{Name: `organization-following`, Usage: ``},
 				cli.IntFlag{Name: `organization-id`, Usage: ``},
 				cli.StringFlag{Name: `organization-location`, Usage: ``},
-				cli.StringFlag{Name: `organization-email`, Usage: ``},
+				cli.StringFlag{Name: `organization-login`, Usage: ``},
+				cli.StringFlag{Name: `organization-name`, Usage: ``},
+				cli.IntFlag{Name: `organization-owned-private-repos`, Usage: ``},
+				cli.IntFlag{Name: `organization-plan-collaborators`, Usage: ``},
+				cli.StringFlag{Name: `organization-plan-name`, Usage: ``},
+				cli.IntFlag{Name: `organization-plan-private-repos`, Usage: ``},
+				cli.IntFlag{Name: `organization-plan-space`, Usage: ``},
+				cli.IntFlag{Name: `organization-private-gists`, Usage: ``},
 				cli.IntFlag{Name: `organization-public-gists`, Usage: ``},
-				cli.StringFlag{Name: `organization-updated-at`, Usage: ``},
+				cli.IntFlag{Name: `organization-public-repos`, Usage: ``},
 				cli.IntFlag{Name: `organization-total-private-repos`, Usage: ``},
 				cli.StringFlag{Name: `organization-type`, Usage: ``},
-				cli.StringFlag{Name: `organization-company`, Usage: ``},
-				cli.StringFlag{Name: `organization-blog`, Usage: ``},
-				cli.IntFlag{Name: `organization-owned-private-repos`, Usage: ``},
-				cli.IntFlag{Name: `organization-public-repos`, Usage: ``},
-				cli.IntFlag{Name: `organization-following`, Usage: ``},
-				cli.StringFlag{Name: `organization-name`, Usage: ``},
-				cli.IntFlag{Name: `organization-disk-usage`, Usage: ``},
-				cli.IntFlag{Name: `organization-collaborators`, Usage: ``},
-				cli.IntFlag{Name: `organization-followers`, Usage: ``},
-				cli.IntFlag{Name: `organization-private-gists`, Usage: ``},
-				cli.StringFlag{Name: `organization-login`, Usage: ``},
-				cli.StringFlag{Name: `organization-billing-email`, Usage: ``},
-				cli.BoolFlag{Name: `private`, Usage: `Additional mutable fields when creating and editing a repository`},
-				cli.BoolFlag{Name: `has-issues`, Usage: ``},
-				cli.IntFlag{Name: `owner-private-gists`, Usage: ``},
-				cli.StringFlag{Name: `owner-name`, Usage: ``},
-				cli.IntFlag{Name: `owner-public-gists`, Usage: ``},
+				cli.StringFlag{Name: `organization-updated-at`, Usage: ``},
+				cli.StringFlag{Name: `owner-bio`, Usage: ``},
+				cli.StringFlag{Name: `owner-blog`, Usage: ``},
+				cli.IntFlag{Name: `owner-collaborators`, Usage: ``},
+				cli.StringFlag{Name: `owner-company`, Usage: ``},
+				cli.StringFlag{Name: `owner-created-at`, Usage: ``},
+				cli.IntFlag{Name: `owner-disk-usage`, Usage: ``},
+				cli.StringFlag{Name: `owner-email`, Usage: ``},
 				cli.IntFlag{Name: `owner-followers`, Usage: ``},
-				cli.IntFlag{Name: `owner-total-private-repos`, Usage: ``},
+				cli.IntFlag{Name: `owner-following`, Usage: ``},
+				cli.StringFlag{Name: `owner-gravatar-id`, Usage: ``},
+				cli.BoolFlag{Name: `owner-hireable`, Usage: ``},
+				cli.IntFlag{Name: `owner-id`, Usage: ``},
+				cli.StringFlag{Name: `owner-location`, Usage: ``},
+				cli.StringFlag{Name: `owner-login`, Usage: ``},
+				cli.StringFlag{Name: `owner-name`, Usage: ``},
 				cli.IntFlag{Name: `owner-owned-private-repos`, Usage: ``},
-				cli.IntFlag{Name: `owner-collaborators`, Usage: ``},
-				cli.StringFlag{Name: `owner-plan-name`, Usage: ``},
-				cli.IntFlag{Name: `owner-plan-space`, Usage: ``},
 				cli.IntFlag{Name: `owner-plan-collaborators`, Usage: ``},
+				cli.StringFlag{Name: `owner-plan-name`, Usage: ``},
 				cli.IntFlag{Name: `owner-plan-private-repos`, Usage: ``},
-				cli.StringFlag{Name: `owner-gravatar-id`, Usage: ``},
-				cli.StringFlag{Name: `owner-blog`, Usage: ``},
-				cli.StringFlag{Name: `owner-created-at`, Usage: ``},
-				cli.IntFlag{Name: `owner-id`, Usage: ``},
+				cli.IntFlag{Name: `owner-plan-space`, Usage: ``},
+				cli.IntFlag{Name: `owner-private-gists`, Usage: ``},
+				cli.IntFlag{Name: `owner-public-gists`, Usage: ``},
 				cli.IntFlag{Name: `owner-public-repos`, Usage: ``},
-				cli.StringFlag{Name: `owner-updated-at`, Usage: ``},
-				cli.StringFlag{Name: `owner-bio`, Usage: ``},
 				cli.BoolFlag{Name: `owner-site-admin`, Usage: ``},
-				cli.StringFlag{Name: `owner-location`, Usage: ``},
-				cli.IntFlag{Name: `owner-disk-usage`, Usage: ``},
-				cli.BoolFlag{Name: `owner-hireable`, Usage: ``},
-				cli.StringFlag{Name: `owner-email`, Usage: ``},
-				cli.IntFlag{Name: `owner-following`, Usage: ``},
+				cli.IntFlag{Name: `owner-total-private-repos`, Usage: ``},
 				cli.StringFlag{Name: `owner-type`, Usage: ``},
-				cli.StringFlag{Name: `owner-login`, Usage: ``},
-				cli.StringFlag{Name: `owner-company`, Usage: ``},
-				cli.StringFlag{Name: `homepage`, Usage: ``},
-				cli.StringFlag{Name: `license-implementation`, Usage: ``},
-				cli.StringSliceFlag{Name: `license-forbidden`, Usage: ``},
-				cli.StringFlag{Name: `license-key`, Usage: ``},
-				cli.StringFlag{Name: `license-category`, Usage: ``},
-				cli.StringSliceFlag{Name: `license-permitted`, Usage: ``},
-				cli.StringFlag{Name: `license-body`, Usage: ``},
-				cli.StringFlag{Name: `license-name`, Usage: ``},
-				cli.BoolFlag{Name: `license-featured`, Usage: ``},
-				cli.StringFlag{Name: `license-description`, Usage: ``},
-				cli.StringSliceFlag{Name: `license-required`, Usage: ``},
-				cli.StringFlag{Name: `description`, Usage: ``},
-				cli.BoolFlag{Name: `auto-init`, Usage: ``},
-				cli.IntFlag{Name: `team-id`, Usage: `Creating an organization repository. Required for non-owners.`},
-				cli.StringFlag{Name: `master-branch`, Usage: ``},
-				cli.IntFlag{Name: `stargazers-count`, Usage: ``},
-				cli.StringFlag{Name: `updated-at`, Usage: ``},
-				cli.BoolFlag{Name: `has-wiki`, Usage: ``},
-				cli.StringFlag{Name: `created-at`, Usage: ``},
-				cli.IntFlag{Name: `size`, Usage: ``},
-				cli.StringFlag{Name: `default-branch`, Usage: ``},
-				cli.IntFlag{Name: `forks-count`, Usage: ``},
+				cli.StringFlag{Name: `owner-updated-at`, Usage: ``},
+				cli.BoolFlag{Name: `private`, Usage: `Additional mutable fields when creating and editing a repository`},
 				cli.StringFlag{Name: `pushed-at`, Usage: ``},
-				cli.BoolFlag{Name: `fork`, Usage: ``},
+				cli.IntFlag{Name: `size`, Usage: ``},
+				cli.IntFlag{Name: `stargazers-count`, Usage: ``},
 				cli.IntFlag{Name: `subscribers-count`, Usage: ``},
-				cli.StringFlag{Name: `language`, Usage: ``},
+				cli.IntFlag{Name: `team-id`, Usage: `Creating an organization repository. Required for non-owners.`},
+				cli.StringFlag{Name: `updated-at`, Usage: ``},
 				cli.IntFlag{Name: `watchers-count`, Usage: ``},
-				cli.StringFlag{Name: `name`, Usage: ``},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the Repository, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 1 {
+				args := c.Args()
+				if len(args) < 1 {
 					showHelp(c, "create", "create <org>")
 				}
 
-				org := c.Args().Get(0)
-				repo := &github.Repository{
-					FullName:         github.String(c.String("full-name")),
-					NetworkCount:     github.Int(c.Int("network-count")),
-					HasDownloads:     github.Bool(c.Bool("has-downloads")),
-					ID:               github.Int(c.Int("id")),
-					Private:          github.Bool(c.Bool("private")),
-					HasIssues:        github.Bool(c.Bool("has-issues")),
-					OpenIssuesCount:  github.Int(c.Int("open-issues-count")),
-					Homepage:         github.String(c.String("homepage")),
-					Description:      github.String(c.String("description")),
-					TeamID:           github.Int(c.Int("team-id")),
-					MasterBranch:     github.String(c.String("master-branch")),
-					AutoInit:         github.Bool(c.Bool("auto-init")),
-					StargazersCount:  github.Int(c.Int("stargazers-count")),
-					UpdatedAt:        &github.Timestamp{now.MustParse(c.String("updated-at"))},
-					CreatedAt:        &github.Timestamp{now.MustParse(c.String("created-at"))},
-					HasWiki:          github.Bool(c.Bool("has-wiki")),
-					DefaultBranch:    github.String(c.String("default-branch")),
-					Size:             github.Int(c.Int("size")),
-					PushedAt:         &github.Timestamp{now.MustParse(c.String("pushed-at"))},
-					ForksCount:       github.Int(c.Int("forks-count")),
-					Language:         github.String(c.String("language")),
-					Fork:             github.Bool(c.Bool("fork")),
-					SubscribersCount: github.Int(c.Int("subscribers-count")),
-					WatchersCount:    github.Int(c.Int("watchers-count")),
-					Name:             github.String(c.String("name")),
+				org := args.Get(0)
+				repo := &github.Repository{}
+				check(decodeFile(c.String("body-file"), repo))
+				if c.IsSet("auto-init") {
+					repo.AutoInit = github.Bool(c.Bool("auto-init"))
+				}
+				if c.IsSet("created-at") {
+					repo.CreatedAt = &github.Timestamp{Time: timeFlag(c, "created-at")}
+				}
+				if c.IsSet("default-branch") {
+					repo.DefaultBranch = github.String(c.String("default-branch"))
+				}
+				if c.IsSet("description") {
+					repo.Description = github.String(c.String("description"))
+				}
+				if c.IsSet("fork") {
+					repo.Fork = github.Bool(c.Bool("fork"))
+				}
+				if c.IsSet("forks-count") {
+					repo.ForksCount = github.Int(c.Int("forks-count"))
+				}
+				if c.IsSet("full-name") {
+					repo.FullName = github.String(c.String("full-name"))
+				}
+				if c.IsSet("has-downloads") {
+					repo.HasDownloads = github.Bool(c.Bool("has-downloads"))
+				}
+				if c.IsSet("has-issues") {
+					repo.HasIssues = github.Bool(c.Bool("has-issues"))
+				}
+				if c.IsSet("has-wiki") {
+					repo.HasWiki = github.Bool(c.Bool("has-wiki"))
+				}
+				if c.IsSet("homepage") {
+					repo.Homepage = github.String(c.String("homepage"))
+				}
+				if c.IsSet("id") {
+					repo.ID = github.Int(c.Int("id"))
+				}
+				if c.IsSet("language") {
+					repo.Language = github.String(c.String("language"))
+				}
+				if c.IsSet("master-branch") {
+					repo.MasterBranch = github.String(c.String("master-branch"))
+				}
+				if c.IsSet("name") {
+					repo.Name = github.String(c.String("name"))
+				}
+				if c.IsSet("network-count") {
+					repo.NetworkCount = github.Int(c.Int("network-count"))
+				}
+				if c.IsSet("open-issues-count") {
+					repo.OpenIssuesCount = github.Int(c.Int("open-issues-count"))
+				}
+				if c.IsSet("private") {
+					repo.Private = github.Bool(c.Bool("private"))
+				}
+				if c.IsSet("pushed-at") {
+					repo.PushedAt = &github.Timestamp{Time: timeFlag(c, "pushed-at")}
+				}
+				if c.IsSet("size") {
+					repo.Size = github.Int(c.Int("size"))
+				}
+				if c.IsSet("stargazers-count") {
+					repo.StargazersCount = github.Int(c.Int("stargazers-count"))
+				}
+				if c.IsSet("subscribers-count") {
+					repo.SubscribersCount = github.Int(c.Int("subscribers-count"))
+				}
+				if c.IsSet("team-id") {
+					repo.TeamID = github.Int(c.Int("team-id"))
+				}
+				if c.IsSet("updated-at") {
+					repo.UpdatedAt = &github.Timestamp{Time: timeFlag(c, "updated-at")}
+				}
+				if c.IsSet("watchers-count") {
+					repo.WatchersCount = github.Int(c.Int("watchers-count"))
 				}
 
 				result, res, err := app.gh.Repositories.Create(org, repo)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "Repository", result)
 
 			},
 		}, cli.Command{
@@ -278,16 +350,17 @@ forks, sources, member.  Default is "all".`},
    GitHub API docs: http://developer.github.com/v3/repos/#get`,
 			Flags: []cli.Flag{},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 2 {
-					showHelp(c, "get", "get <owner> <repo>")
+				args := repoArgs(c, 0)
+				if len(args) < 2 {
+					showHelp(c, "get", "get [<owner> <repo>]")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
+				owner := args.Get(0)
+				repo := args.Get(1)
 
 				result, res, err := app.gh.Repositories.Get(owner, repo)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "Repository", result)
 
 			},
 		}, cli.Command{
@@ -297,131 +370,183 @@ forks, sources, member.  Default is "all".`},
 
    GitHub API docs: http://developer.github.com/v3/repos/#edit`,
 			Flags: []cli.Flag{
-				cli.StringFlag{Name: `master-branch`, Usage: ``},
 				cli.BoolFlag{Name: `auto-init`, Usage: ``},
-				cli.IntFlag{Name: `team-id`, Usage: `Creating an organization repository. Required for non-owners.`},
-				cli.IntFlag{Name: `stargazers-count`, Usage: ``},
-				cli.StringFlag{Name: `updated-at`, Usage: ``},
 				cli.StringFlag{Name: `created-at`, Usage: ``},
-				cli.BoolFlag{Name: `has-wiki`, Usage: ``},
 				cli.StringFlag{Name: `default-branch`, Usage: ``},
-				cli.IntFlag{Name: `size`, Usage: ``},
-				cli.StringFlag{Name: `pushed-at`, Usage: ``},
-				cli.IntFlag{Name: `forks-count`, Usage: ``},
-				cli.StringFlag{Name: `language`, Usage: ``},
+				cli.StringFlag{Name: `description`, Usage: ``},
 				cli.BoolFlag{Name: `fork`, Usage: ``},
-				cli.IntFlag{Name: `subscribers-count`, Usage: ``},
-				cli.IntFlag{Name: `watchers-count`, Usage: ``},
-				cli.StringFlag{Name: `name`, Usage: ``},
+				cli.IntFlag{Name: `forks-count`, Usage: ``},
 				cli.StringFlag{Name: `full-name`, Usage: ``},
-				cli.IntFlag{Name: `network-count`, Usage: ``},
 				cli.BoolFlag{Name: `has-downloads`, Usage: ``},
-				cli.IntFlag{Name: `id`, Usage: ``},
 				cli.BoolFlag{Name: `has-issues`, Usage: ``},
-				cli.StringFlag{Name: `owner-bio`, Usage: ``},
-				cli.BoolFlag{Name: `owner-site-admin`, Usage: ``},
-				cli.StringFlag{Name: `owner-location`, Usage: ``},
-				cli.IntFlag{Name: `owner-disk-usage`, Usage: ``},
-				cli.BoolFlag{Name: `owner-hireable`, Usage: ``},
-				cli.IntFlag{Name: `owner-following`, Usage: ``},
-				cli.StringFlag{Name: `owner-type`, Usage: ``},
-				cli.StringFlag{Name: `owner-login`, Usage: ``},
-				cli.StringFlag{Name: `owner-company`, Usage: ``},
-				cli.StringFlag{Name: `owner-email`, Usage: ``},
-				cli.StringFlag{Name: `owner-name`, Usage: ``},
-				cli.IntFlag{Name: `owner-public-gists`, Usage: ``},
-				cli.IntFlag{Name: `owner-followers`, Usage: ``},
-				cli.IntFlag{Name: `owner-private-gists`, Usage: ``},
-				cli.IntFlag{Name: `owner-owned-private-repos`, Usage: ``},
-				cli.IntFlag{Name: `owner-collaborators`, Usage: ``},
-				cli.IntFlag{Name: `owner-plan-collaborators`, Usage: ``},
-				cli.IntFlag{Name: `owner-plan-private-repos`, Usage: ``},
-				cli.StringFlag{Name: `owner-plan-name`, Usage: ``},
-				cli.IntFlag{Name: `owner-plan-space`, Usage: ``},
-				cli.StringFlag{Name: `owner-gravatar-id`, Usage: ``},
-				cli.StringFlag{Name: `owner-blog`, Usage: ``},
-				cli.StringFlag{Name: `owner-created-at`, Usage: ``},
-				cli.IntFlag{Name: `owner-total-private-repos`, Usage: ``},
-				cli.IntFlag{Name: `owner-id`, Usage: ``},
-				cli.IntFlag{Name: `owner-public-repos`, Usage: ``},
-				cli.StringFlag{Name: `owner-updated-at`, Usage: ``},
+				cli.BoolFlag{Name: `has-wiki`, Usage: ``},
+				cli.StringFlag{Name: `homepage`, Usage: ``},
+				cli.IntFlag{Name: `id`, Usage: ``},
+				cli.StringFlag{Name: `language`, Usage: ``},
+				cli.StringFlag{Name: `license-body`, Usage: ``},
+				cli.StringFlag{Name: `license-category`, Usage: ``},
+				cli.StringFlag{Name: `license-description`, Usage: ``},
+				cli.BoolFlag{Name: `license-featured`, Usage: ``},
+				cli.StringSliceFlag{Name: `license-forbidden`, Usage: ``},
+				cli.StringFlag{Name: `license-implementation`, Usage: ``},
+				cli.StringFlag{Name: `license-key`, Usage: ``},
+				cli.StringFlag{Name: `license-name`, Usage: ``},
+				cli.StringSliceFlag{Name: `license-permitted`, Usage: ``},
+				cli.StringSliceFlag{Name: `license-required`, Usage: ``},
+				cli.StringFlag{Name: `master-branch`, Usage: ``},
+				cli.StringFlag{Name: `name`, Usage: ``},
+				cli.IntFlag{Name: `network-count`, Usage: ``},
 				cli.IntFlag{Name: `open-issues-count`, Usage: ``},
+				cli.StringFlag{Name: `organization-billing-email`, Usage: ``},
+				cli.StringFlag{Name: `organization-blog`, Usage: ``},
+				cli.IntFlag{Name: `organization-collaborators`, Usage: ``},
+				cli.StringFlag{Name: `organization-company`, Usage: ``},
 				cli.StringFlag{Name: `organization-created-at`, Usage: ``},
+				cli.IntFlag{Name: `organization-disk-usage`, Usage: ``},
+				cli.StringFlag{Name: `organization-email`, Usage: ``},
+				cli.IntFlag{Name: `organization-followers`, Usage: ``},
+				cli.IntFlag{Name: `organization-following`, Usage: ``},
+				cli.IntFlag{Name: `organization-id`, Usage: ``},
+				cli.StringFlag{Name: `organization-location`, Usage: ``},
+				cli.StringFlag{Name: `organization-login`, Usage: ``},
+				cli.StringFlag{Name: `organization-name`, Usage: ``},
+				cli.IntFlag{Name: `organization-owned-private-repos`, Usage: ``},
 				cli.IntFlag{Name: `organization-plan-collaborators`, Usage: ``},
-				cli.IntFlag{Name: `organization-plan-private-repos`, Usage: ``},
 				cli.StringFlag{Name: `organization-plan-name`, Usage: ``},
+				cli.IntFlag{Name: `organization-plan-private-repos`, Usage: ``},
 				cli.IntFlag{Name: `organization-plan-space`, Usage: ``},
-				cli.IntFlag{Name: `organization-id`, Usage: ``},
-				cli.StringFlag{Name: `organization-location`, Usage: ``},
+				cli.IntFlag{Name: `organization-private-gists`, Usage: ``},
 				cli.IntFlag{Name: `organization-public-gists`, Usage: ``},
-				cli.StringFlag{Name: `organization-updated-at`, Usage: ``},
+				cli.IntFlag{Name: `organization-public-repos`, Usage: ``},
 				cli.IntFlag{Name: `organization-total-private-repos`, Usage: ``},
 				cli.StringFlag{Name: `organization-type`, Usage: ``},
-				cli.StringFlag{Name: `organization-company`, Usage: ``},
-				cli.StringFlag{Name: `organization-blog`, Usage: ``},
-				cli.StringFlag{Name: `organization-email`, Usage: ``},
-				cli.IntFlag{Name: `organization-public-repos`, Usage: ``},
-				cli.IntFlag{Name: `organization-following`, Usage: ``},
-				cli.IntFlag{Name: `organization-owned-private-repos`, Usage: ``},
-				cli.StringFlag{Name: `organization-name`, Usage: ``},
-				cli.IntFlag{Name: `organization-collaborators`, Usage: ``},
-				cli.IntFlag{Name: `organization-followers`, Usage: ``},
-				cli.IntFlag{Name: `organization-private-gists`, Usage: ``},
-				cli.IntFlag{Name: `organization-disk-usage`, Usage: ``},
-				cli.StringFlag{Name: `organization-login`, Usage: ``},
-				cli.StringFlag{Name: `organization-billing-email`, Usage: ``},
+				cli.StringFlag{Name: `organization-updated-at`, Usage: ``},
+				cli.StringFlag{Name: `owner-bio`, Usage: ``},
+				cli.StringFlag{Name: `owner-blog`, Usage: ``},
+				cli.IntFlag{Name: `owner-collaborators`, Usage: ``},
+				cli.StringFlag{Name: `owner-company`, Usage: ``},
+				cli.StringFlag{Name: `owner-created-at`, Usage: ``},
+				cli.IntFlag{Name: `owner-disk-usage`, Usage: ``},
+				cli.StringFlag{Name: `owner-email`, Usage: ``},
+				cli.IntFlag{Name: `owner-followers`, Usage: ``},
+				cli.IntFlag{Name: `owner-following`, Usage: ``},
+				cli.StringFlag{Name: `owner-gravatar-id`, Usage: ``},
+				cli.BoolFlag{Name: `owner-hireable`, Usage: ``},
+				cli.IntFlag{Name: `owner-id`, Usage: ``},
+				cli.StringFlag{Name: `owner-location`, Usage: ``},
+				cli.StringFlag{Name: `owner-login`, Usage: ``},
+				cli.StringFlag{Name: `owner-name`, Usage: ``},
+				cli.IntFlag{Name: `owner-owned-private-repos`, Usage: ``},
+				cli.IntFlag{Name: `owner-plan-collaborators`, Usage: ``},
+				cli.StringFlag{Name: `owner-plan-name`, Usage: ``},
+				cli.IntFlag{Name: `owner-plan-private-repos`, Usage: ``},
+				cli.IntFlag{Name: `owner-plan-space`, Usage: ``},
+				cli.IntFlag{Name: `owner-private-gists`, Usage: ``},
+				cli.IntFlag{Name: `owner-public-gists`, Usage: ``},
+				cli.IntFlag{Name: `owner-public-repos`, Usage: ``},
+				cli.BoolFlag{Name: `owner-site-admin`, Usage: ``},
+				cli.IntFlag{Name: `owner-total-private-repos`, Usage: ``},
+				cli.StringFlag{Name: `owner-type`, Usage: ``},
+				cli.StringFlag{Name: `owner-updated-at`, Usage: ``},
 				cli.BoolFlag{Name: `private`, Usage: `Additional mutable fields when creating and editing a repository`},
-				cli.StringFlag{Name: `homepage`, Usage: ``},
-				cli.StringFlag{Name: `description`, Usage: ``},
-				cli.StringFlag{Name: `license-body`, Usage: ``},
-				cli.StringFlag{Name: `license-name`, Usage: ``},
-				cli.BoolFlag{Name: `license-featured`, Usage: ``},
-				cli.StringFlag{Name: `license-description`, Usage: ``},
-				cli.StringSliceFlag{Name: `license-required`, Usage: ``},
-				cli.StringSliceFlag{Name: `license-permitted`, Usage: ``},
-				cli.StringSliceFlag{Name: `license-forbidden`, Usage: ``},
-				cli.StringFlag{Name: `license-key`, Usage: ``},
-				cli.StringFlag{Name: `license-category`, Usage: ``},
-				cli.StringFlag{Name: `license-implementation`, Usage: ``},
+				cli.StringFlag{Name: `pushed-at`, Usage: ``},
+				cli.IntFlag{Name: `size`, Usage: ``},
+				cli.IntFlag{Name: `stargazers-count`, Usage: ``},
+				cli.IntFlag{Name: `subscribers-count`, Usage: ``},
+				cli.IntFlag{Name: `team-id`, Usage: `Creating an organization repository. Required for non-owners.`},
+				cli.StringFlag{Name: `updated-at`, Usage: ``},
+				cli.IntFlag{Name: `watchers-count`, Usage: ``},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the Repository, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 2 {
-					showHelp(c, "edit", "edit <owner> <repo>")
-				}
-
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				repository := &github.Repository{
-					StargazersCount:  github.Int(c.Int("stargazers-count")),
-					UpdatedAt:        &github.Timestamp{now.MustParse(c.String("updated-at"))},
-					HasWiki:          github.Bool(c.Bool("has-wiki")),
-					CreatedAt:        &github.Timestamp{now.MustParse(c.String("created-at"))},
-					Size:             github.Int(c.Int("size")),
-					DefaultBranch:    github.String(c.String("default-branch")),
-					ForksCount:       github.Int(c.Int("forks-count")),
-					PushedAt:         &github.Timestamp{now.MustParse(c.String("pushed-at"))},
-					Fork:             github.Bool(c.Bool("fork")),
-					SubscribersCount: github.Int(c.Int("subscribers-count")),
-					Language:         github.String(c.String("language")),
-					WatchersCount:    github.Int(c.Int("watchers-count")),
-					Name:             github.String(c.String("name")),
-					FullName:         github.String(c.String("full-name")),
-					HasDownloads:     github.Bool(c.Bool("has-downloads")),
-					NetworkCount:     github.Int(c.Int("network-count")),
-					ID:               github.Int(c.Int("id")),
-					OpenIssuesCount:  github.Int(c.Int("open-issues-count")),
-					Private:          github.Bool(c.Bool("private")),
-					HasIssues:        github.Bool(c.Bool("has-issues")),
-					Homepage:         github.String(c.String("homepage")),
-					Description:      github.String(c.String("description")),
-					AutoInit:         github.Bool(c.Bool("auto-init")),
-					TeamID:           github.Int(c.Int("team-id")),
-					MasterBranch:     github.String(c.String("master-branch")),
+				args := repoArgs(c, 0)
+				if len(args) < 2 {
+					showHelp(c, "edit", "edit [<owner> <repo>]")
+				}
+
+				owner := args.Get(0)
+				repo := args.Get(1)
+				repository := &github.Repository{}
+				check(decodeFile(c.String("body-file"), repository))
+				if c.IsSet("auto-init") {
+					repository.AutoInit = github.Bool(c.Bool("auto-init"))
+				}
+				if c.IsSet("created-at") {
+					repository.CreatedAt = &github.Timestamp{Time: timeFlag(c, "created-at")}
+				}
+				if c.IsSet("default-branch") {
+					repository.DefaultBranch = github.String(c.String("default-branch"))
+				}
+				if c.IsSet("description") {
+					repository.Description = github.String(c.String("description"))
+				}
+				if c.IsSet("fork") {
+					repository.Fork = github.Bool(c.Bool("fork"))
+				}
+				if c.IsSet("forks-count") {
+					repository.ForksCount = github.Int(c.Int("forks-count"))
+				}
+				if c.IsSet("full-name") {
+					repository.FullName = github.String(c.String("full-name"))
+				}
+				if c.IsSet("has-downloads") {
+					repository.HasDownloads = github.Bool(c.Bool("has-downloads"))
+				}
+				if c.IsSet("has-issues") {
+					repository.HasIssues = github.Bool(c.Bool("has-issues"))
+				}
+				if c.IsSet("has-wiki") {
+					repository.HasWiki = github.Bool(c.Bool("has-wiki"))
+				}
+				if c.IsSet("homepage") {
+					repository.Homepage = github.String(c.String("homepage"))
+				}
+				if c.IsSet("id") {
+					repository.ID = github.Int(c.Int("id"))
+				}
+				if c.IsSet("language") {
+					repository.Language = github.String(c.String("language"))
+				}
+				if c.IsSet("master-branch") {
+					repository.MasterBranch = github.String(c.String("master-branch"))
+				}
+				if c.IsSet("name") {
+					repository.Name = github.String(c.String("name"))
+				}
+				if c.IsSet("network-count") {
+					repository.NetworkCount = github.Int(c.Int("network-count"))
+				}
+				if c.IsSet("open-issues-count") {
+					repository.OpenIssuesCount = github.Int(c.Int("open-issues-count"))
+				}
+				if c.IsSet("private") {
+					repository.Private = github.Bool(c.Bool("private"))
+				}
+				if c.IsSet("pushed-at") {
+					repository.PushedAt = &github.Timestamp{Time: timeFlag(c, "pushed-at")}
+				}
+				if c.IsSet("size") {
+					repository.Size = github.Int(c.Int("size"))
+				}
+				if c.IsSet("stargazers-count") {
+					repository.StargazersCount = github.Int(c.Int("stargazers-count"))
+				}
+				if c.IsSet("subscribers-count") {
+					repository.SubscribersCount = github.Int(c.Int("subscribers-count"))
+				}
+				if c.IsSet("team-id") {
+					repository.TeamID = github.Int(c.Int("team-id"))
+				}
+				if c.IsSet("updated-at") {
+					repository.UpdatedAt = &github.Timestamp{Time: timeFlag(c, "updated-at")}
+				}
+				if c.IsSet("watchers-count") {
+					repository.WatchersCount = github.Int(c.Int("watchers-count"))
 				}
 
 				result, res, err := app.gh.Repositories.Edit(owner, repo, repository)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "Repository", result)
 
 			},
 		}, cli.Command{
@@ -430,17 +555,22 @@ forks, sources, member.  Default is "all".`},
 			Description: `delete a repository.
 
    GitHub API docs: https://developer.github.com/v3/repos/#delete-a-repository`,
-			Flags: []cli.Flag{},
+			Flags: []cli.Flag{
+				cli.BoolFlag{Name: `yes`, Usage: `Don't ask for confirmation`},
+			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 2 {
-					showHelp(c, "delete", "delete <owner> <repo>")
+				args := repoArgs(c, 0)
+				if len(args) < 2 {
+					showHelp(c, "delete", "delete [<owner> <repo>]")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
+				owner := args.Get(0)
+				repo := args.Get(1)
+
+				confirm(c, "delete", repo)
 
 				res, err := app.gh.Repositories.Delete(owner, repo)
-				checkResponse(res.Response, err)
+				checkResponse(res, err)
 
 			},
 		}, cli.Command{
@@ -450,36 +580,42 @@ forks, sources, member.  Default is "all".`},
 
    GitHub API docs: http://developer.github.com/v3/repos/#list-contributors`,
 			Flags: []cli.Flag{
+				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
 				cli.StringFlag{Name: `anon`, Usage: `Include anonymous contributors in results or not`},
+				cli.IntFlag{Name: `limit`, Usage: `Stop after this many items, fetching the following pages as needed`},
 				cli.IntFlag{Name: `page`, Usage: `For paginated result sets, page of results to retrieve.`},
 				cli.IntFlag{Name: `per-page`, Usage: `For paginated result sets, the number of results to include per page.`},
-				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the ListContributorsOptions, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 2 {
-					showHelp(c, "list-contributors", "list-contributors <owner> <repository>")
+				args := repoArgs(c, 0)
+				if len(args) < 2 {
+					showHelp(c, "list-contributors", "list-contributors [<owner> <repo>]")
 				}
 
-				owner := c.Args().Get(0)
-				repository := c.Args().Get(1)
-				opt := &github.ListContributorsOptions{
-					Anon: c.String("anon"),
+				owner := args.Get(0)
+				repository := args.Get(1)
+				opt := &github.ListContributorsOptions{}
+				check(decodeFile(c.String("body-file"), opt))
+				if c.IsSet("anon") {
+					opt.Anon = c.String("anon")
+				}
+				if c.IsSet("page") {
+					opt.Page = c.Int("page")
+				}
+				if c.IsSet("per-page") {
+					opt.PerPage = c.Int("per-page")
 				}
 
-				var items []github.Contributor
-
-				for {
-					page, res, err := app.gh.Repositories.ListContributors(owner, repository, opt)
-					checkResponse(res.Response, err)
-
-					items = append(items, page...)
-					if res.NextPage == 0 || !c.Bool("all") {
-						break
-					}
-					opt.Page = res.NextPage
+				if opt.PerPage == 0 {
+					opt.PerPage = app.profile.PerPage
 				}
 
-				fmt.Printf("%# v", pretty.Formatter(items))
+				paginate(c, "Contributor", func(page int) (interface{}, *github.Response, error) {
+					opt := *opt
+					opt.Page = page
+					return app.gh.Repositories.ListContributors(owner, repository, &opt)
+				})
 			},
 		}, cli.Command{
 			Name:  "list-languages",
@@ -496,16 +632,17 @@ forks, sources, member.  Default is "all".`},
    GitHub API Docs: http://developer.github.com/v3/repos/#list-languages`,
 			Flags: []cli.Flag{},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 2 {
-					showHelp(c, "list-languages", "list-languages <owner> <repo>")
+				args := repoArgs(c, 0)
+				if len(args) < 2 {
+					showHelp(c, "list-languages", "list-languages [<owner> <repo>]")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
+				owner := args.Get(0)
+				repo := args.Get(1)
 
 				result, res, err := app.gh.Repositories.ListLanguages(owner, repo)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "map[string]int", result)
 
 			},
 		}, cli.Command{
@@ -518,33 +655,35 @@ forks, sources, member.  Default is "all".`},
 				cli.IntFlag{Name: `page`, Usage: `For paginated result sets, page of results to retrieve.`},
 				cli.IntFlag{Name: `per-page`, Usage: `For paginated result sets, the number of results to include per page.`},
 				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
+				cli.IntFlag{Name: `limit`, Usage: `Stop after this many items, fetching the following pages as needed`},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the ListOptions, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 2 {
-					showHelp(c, "list-teams", "list-teams <owner> <repo>")
+				args := repoArgs(c, 0)
+				if len(args) < 2 {
+					showHelp(c, "list-teams", "list-teams [<owner> <repo>]")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				opt := &github.ListOptions{
-					Page:    c.Int("page"),
-					PerPage: c.Int("per-page"),
+				owner := args.Get(0)
+				repo := args.Get(1)
+				opt := &github.ListOptions{}
+				check(decodeFile(c.String("body-file"), opt))
+				if c.IsSet("page") {
+					opt.Page = c.Int("page")
+				}
+				if c.IsSet("per-page") {
+					opt.PerPage = c.Int("per-page")
 				}
 
-				var items []github.Team
-
-				for {
-					page, res, err := app.gh.Repositories.ListTeams(owner, repo, opt)
-					checkResponse(res.Response, err)
-
-					items = append(items, page...)
-					if res.NextPage == 0 || !c.Bool("all") {
-						break
-					}
-					opt.Page = res.NextPage
+				if opt.PerPage == 0 {
+					opt.PerPage = app.profile.PerPage
 				}
 
-				fmt.Printf("%# v", pretty.Formatter(items))
+				paginate(c, "Team", func(page int) (interface{}, *github.Response, error) {
+					opt := *opt
+					opt.Page = page
+					return app.gh.Repositories.ListTeams(owner, repo, &opt)
+				})
 			},
 		}, cli.Command{
 			Name:  "list-tags",
@@ -556,33 +695,35 @@ forks, sources, member.  Default is "all".`},
 				cli.IntFlag{Name: `page`, Usage: `For paginated result sets, page of results to retrieve.`},
 				cli.IntFlag{Name: `per-page`, Usage: `For paginated result sets, the number of results to include per page.`},
 				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
+				cli.IntFlag{Name: `limit`, Usage: `Stop after this many items, fetching the following pages as needed`},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the ListOptions, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 2 {
-					showHelp(c, "list-tags", "list-tags <owner> <repo>")
+				args := repoArgs(c, 0)
+				if len(args) < 2 {
+					showHelp(c, "list-tags", "list-tags [<owner> <repo>]")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				opt := &github.ListOptions{
-					Page:    c.Int("page"),
-					PerPage: c.Int("per-page"),
+				owner := args.Get(0)
+				repo := args.Get(1)
+				opt := &github.ListOptions{}
+				check(decodeFile(c.String("body-file"), opt))
+				if c.IsSet("page") {
+					opt.Page = c.Int("page")
+				}
+				if c.IsSet("per-page") {
+					opt.PerPage = c.Int("per-page")
 				}
 
-				var items []github.RepositoryTag
-
-				for {
-					page, res, err := app.gh.Repositories.ListTags(owner, repo, opt)
-					checkResponse(res.Response, err)
-
-					items = append(items, page...)
-					if res.NextPage == 0 || !c.Bool("all") {
-						break
-					}
-					opt.Page = res.NextPage
+				if opt.PerPage == 0 {
+					opt.PerPage = app.profile.PerPage
 				}
 
-				fmt.Printf("%# v", pretty.Formatter(items))
+				paginate(c, "RepositoryTag", func(page int) (interface{}, *github.Response, error) {
+					opt := *opt
+					opt.Page = page
+					return app.gh.Repositories.ListTags(owner, repo, &opt)
+				})
 			},
 		}, cli.Command{
 			Name:  "list-branches",
@@ -591,36 +732,38 @@ forks, sources, member.  Default is "all".`},
 
    GitHub API docs: http://developer.github.com/v3/repos/#list-branches`,
 			Flags: []cli.Flag{
-				cli.IntFlag{Name: `per-page`, Usage: `For paginated result sets, the number of results to include per page.`},
 				cli.IntFlag{Name: `page`, Usage: `For paginated result sets, page of results to retrieve.`},
+				cli.IntFlag{Name: `per-page`, Usage: `For paginated result sets, the number of results to include per page.`},
 				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
+				cli.IntFlag{Name: `limit`, Usage: `Stop after this many items, fetching the following pages as needed`},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the ListOptions, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 2 {
-					showHelp(c, "list-branches", "list-branches <owner> <repo>")
+				args := repoArgs(c, 0)
+				if len(args) < 2 {
+					showHelp(c, "list-branches", "list-branches [<owner> <repo>]")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				opt := &github.ListOptions{
-					Page:    c.Int("page"),
-					PerPage: c.Int("per-page"),
+				owner := args.Get(0)
+				repo := args.Get(1)
+				opt := &github.ListOptions{}
+				check(decodeFile(c.String("body-file"), opt))
+				if c.IsSet("page") {
+					opt.Page = c.Int("page")
+				}
+				if c.IsSet("per-page") {
+					opt.PerPage = c.Int("per-page")
 				}
 
-				var items []github.Branch
-
-				for {
-					page, res, err := app.gh.Repositories.ListBranches(owner, repo, opt)
-					checkResponse(res.Response, err)
-
-					items = append(items, page...)
-					if res.NextPage == 0 || !c.Bool("all") {
-						break
-					}
-					opt.Page = res.NextPage
+				if opt.PerPage == 0 {
+					opt.PerPage = app.profile.PerPage
 				}
 
-				fmt.Printf("%# v", pretty.Formatter(items))
+				paginate(c, "Branch", func(page int) (interface{}, *github.Response, error) {
+					opt := *opt
+					opt.Page = page
+					return app.gh.Repositories.ListBranches(owner, repo, &opt)
+				})
 			},
 		}, cli.Command{
 			Name:  "get-branch",
@@ -630,17 +773,18 @@ forks, sources, member.  Default is "all".`},
    GitHub API docs: https://developer.github.com/v3/repos/#get-branch`,
 			Flags: []cli.Flag{},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 3 {
-					showHelp(c, "get-branch", "get-branch <owner> <repo> <branch>")
+				args := repoArgs(c, 1)
+				if len(args) < 3 {
+					showHelp(c, "get-branch", "get-branch [<owner> <repo>] <branch>")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				branch := c.Args().Get(2)
+				owner := args.Get(0)
+				repo := args.Get(1)
+				branch := args.Get(2)
 
 				result, res, err := app.gh.Repositories.GetBranch(owner, repo, branch)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "Branch", result)
 
 			},
 		}, cli.Command{
@@ -653,33 +797,35 @@ forks, sources, member.  Default is "all".`},
 				cli.IntFlag{Name: `page`, Usage: `For paginated result sets, page of results to retrieve.`},
 				cli.IntFlag{Name: `per-page`, Usage: `For paginated result sets, the number of results to include per page.`},
 				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
+				cli.IntFlag{Name: `limit`, Usage: `Stop after this many items, fetching the following pages as needed`},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the ListOptions, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 2 {
-					showHelp(c, "list-collaborators", "list-collaborators <owner> <repo>")
+				args := repoArgs(c, 0)
+				if len(args) < 2 {
+					showHelp(c, "list-collaborators", "list-collaborators [<owner> <repo>]")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				opt := &github.ListOptions{
-					Page:    c.Int("page"),
-					PerPage: c.Int("per-page"),
+				owner := args.Get(0)
+				repo := args.Get(1)
+				opt := &github.ListOptions{}
+				check(decodeFile(c.String("body-file"), opt))
+				if c.IsSet("page") {
+					opt.Page = c.Int("page")
+				}
+				if c.IsSet("per-page") {
+					opt.PerPage = c.Int("per-page")
 				}
 
-				var items []github.User
-
-				for {
-					page, res, err := app.gh.Repositories.ListCollaborators(owner, repo, opt)
-					checkResponse(res.Response, err)
-
-					items = append(items, page...)
-					if res.NextPage == 0 || !c.Bool("all") {
-						break
-					}
-					opt.Page = res.NextPage
+				if opt.PerPage == 0 {
+					opt.PerPage = app.profile.PerPage
 				}
 
-				fmt.Printf("%# v", pretty.Formatter(items))
+				paginate(c, "User", func(page int) (interface{}, *github.Response, error) {
+					opt := *opt
+					opt.Page = page
+					return app.gh.Repositories.ListCollaborators(owner, repo, &opt)
+				})
 			},
 		}, cli.Command{
 			Name:  "is-collaborator",
@@ -692,17 +838,18 @@ forks, sources, member.  Default is "all".`},
    GitHub API docs: http://developer.github.com/v3/repos/collaborators/#get`,
 			Flags: []cli.Flag{},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 3 {
-					showHelp(c, "is-collaborator", "is-collaborator <owner> <repo> <user>")
+				args := repoArgs(c, 1)
+				if len(args) < 3 {
+					showHelp(c, "is-collaborator", "is-collaborator [<owner> <repo>] <user>")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				user := c.Args().Get(2)
+				owner := args.Get(0)
+				repo := args.Get(1)
+				user := args.Get(2)
 
 				result, res, err := app.gh.Repositories.IsCollaborator(owner, repo, user)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "bool", result)
 
 			},
 		}, cli.Command{
@@ -713,16 +860,17 @@ forks, sources, member.  Default is "all".`},
    GitHub API docs: http://developer.github.com/v3/repos/collaborators/#add-collaborator`,
 			Flags: []cli.Flag{},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 3 {
-					showHelp(c, "add-collaborator", "add-collaborator <owner> <repo> <user>")
+				args := repoArgs(c, 1)
+				if len(args) < 3 {
+					showHelp(c, "add-collaborator", "add-collaborator [<owner> <repo>] <user>")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				user := c.Args().Get(2)
+				owner := args.Get(0)
+				repo := args.Get(1)
+				user := args.Get(2)
 
 				res, err := app.gh.Repositories.AddCollaborator(owner, repo, user)
-				checkResponse(res.Response, err)
+				checkResponse(res, err)
 
 			},
 		}, cli.Command{
@@ -732,18 +880,23 @@ forks, sources, member.  Default is "all".`},
    Note: Does not return error if a valid user that is not a collaborator is removed.
 
    GitHub API docs: http://developer.github.com/v3/repos/collaborators/#remove-collaborator`,
-			Flags: []cli.Flag{},
+			Flags: []cli.Flag{
+				cli.BoolFlag{Name: `yes`, Usage: `Don't ask for confirmation`},
+			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 3 {
-					showHelp(c, "remove-collaborator", "remove-collaborator <owner> <repo> <user>")
+				args := repoArgs(c, 1)
+				if len(args) < 3 {
+					showHelp(c, "remove-collaborator", "remove-collaborator [<owner> <repo>] <user>")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				user := c.Args().Get(2)
+				owner := args.Get(0)
+				repo := args.Get(1)
+				user := args.Get(2)
+
+				confirm(c, "remove-collaborator", repo)
 
 				res, err := app.gh.Repositories.RemoveCollaborator(owner, repo, user)
-				checkResponse(res.Response, err)
+				checkResponse(res, err)
 
 			},
 		}, cli.Command{
@@ -756,33 +909,35 @@ forks, sources, member.  Default is "all".`},
 				cli.IntFlag{Name: `page`, Usage: `For paginated result sets, page of results to retrieve.`},
 				cli.IntFlag{Name: `per-page`, Usage: `For paginated result sets, the number of results to include per page.`},
 				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
+				cli.IntFlag{Name: `limit`, Usage: `Stop after this many items, fetching the following pages as needed`},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the ListOptions, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 2 {
-					showHelp(c, "list-comments", "list-comments <owner> <repo>")
+				args := repoArgs(c, 0)
+				if len(args) < 2 {
+					showHelp(c, "list-comments", "list-comments [<owner> <repo>]")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				opt := &github.ListOptions{
-					Page:    c.Int("page"),
-					PerPage: c.Int("per-page"),
+				owner := args.Get(0)
+				repo := args.Get(1)
+				opt := &github.ListOptions{}
+				check(decodeFile(c.String("body-file"), opt))
+				if c.IsSet("page") {
+					opt.Page = c.Int("page")
+				}
+				if c.IsSet("per-page") {
+					opt.PerPage = c.Int("per-page")
 				}
 
-				var items []github.RepositoryComment
-
-				for {
-					page, res, err := app.gh.Repositories.ListComments(owner, repo, opt)
-					checkResponse(res.Response, err)
-
-					items = append(items, page...)
-					if res.NextPage == 0 || !c.Bool("all") {
-						break
-					}
-					opt.Page = res.NextPage
+				if opt.PerPage == 0 {
+					opt.PerPage = app.profile.PerPage
 				}
 
-				fmt.Printf("%# v", pretty.Formatter(items))
+				paginate(c, "RepositoryComment", func(page int) (interface{}, *github.Response, error) {
+					opt := *opt
+					opt.Page = page
+					return app.gh.Repositories.ListComments(owner, repo, &opt)
+				})
 			},
 		}, cli.Command{
 			Name:  "list-commit-comments",
@@ -794,34 +949,36 @@ forks, sources, member.  Default is "all".`},
 				cli.IntFlag{Name: `page`, Usage: `For paginated result sets, page of results to retrieve.`},
 				cli.IntFlag{Name: `per-page`, Usage: `For paginated result sets, the number of results to include per page.`},
 				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
+				cli.IntFlag{Name: `limit`, Usage: `Stop after this many items, fetching the following pages as needed`},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the ListOptions, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 3 {
-					showHelp(c, "list-commit-comments", "list-commit-comments <owner> <repo> <sha>")
+				args := repoArgs(c, 1)
+				if len(args) < 3 {
+					showHelp(c, "list-commit-comments", "list-commit-comments [<owner> <repo>] <sha>")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				sha := c.Args().Get(2)
-				opt := &github.ListOptions{
-					Page:    c.Int("page"),
-					PerPage: c.Int("per-page"),
+				owner := args.Get(0)
+				repo := args.Get(1)
+				sha := args.Get(2)
+				opt := &github.ListOptions{}
+				check(decodeFile(c.String("body-file"), opt))
+				if c.IsSet("page") {
+					opt.Page = c.Int("page")
+				}
+				if c.IsSet("per-page") {
+					opt.PerPage = c.Int("per-page")
 				}
 
-				var items []github.RepositoryComment
-
-				for {
-					page, res, err := app.gh.Repositories.ListCommitComments(owner, repo, sha, opt)
-					checkResponse(res.Response, err)
-
-					items = append(items, page...)
-					if res.NextPage == 0 || !c.Bool("all") {
-						break
-					}
-					opt.Page = res.NextPage
+				if opt.PerPage == 0 {
+					opt.PerPage = app.profile.PerPage
 				}
 
-				fmt.Printf("%# v", pretty.Formatter(items))
+				paginate(c, "RepositoryComment", func(page int) (interface{}, *github.Response, error) {
+					opt := *opt
+					opt.Page = page
+					return app.gh.Repositories.ListCommitComments(owner, repo, sha, &opt)
+				})
 			},
 		}, cli.Command{
 			Name:  "create-comment",
@@ -831,62 +988,78 @@ forks, sources, member.  Default is "all".`},
 
    GitHub API docs: http://developer.github.com/v3/repos/comments/#create-a-commit-comment`,
 			Flags: []cli.Flag{
-				cli.IntFlag{Name: `id`, Usage: ``},
-				cli.StringFlag{Name: `commit-id`, Usage: ``},
 				cli.StringFlag{Name: `body`, Usage: `User-mutable fields`},
+				cli.StringFlag{Name: `commit-id`, Usage: ``},
+				cli.StringFlag{Name: `created-at`, Usage: ``},
+				cli.IntFlag{Name: `id`, Usage: ``},
 				cli.StringFlag{Name: `path`, Usage: `User-initialized fields`},
 				cli.IntFlag{Name: `position`, Usage: ``},
+				cli.StringFlag{Name: `updated-at`, Usage: ``},
 				cli.StringFlag{Name: `user-bio`, Usage: ``},
-				cli.BoolFlag{Name: `user-site-admin`, Usage: ``},
-				cli.StringFlag{Name: `user-location`, Usage: ``},
-				cli.IntFlag{Name: `user-disk-usage`, Usage: ``},
-				cli.BoolFlag{Name: `user-hireable`, Usage: ``},
-				cli.StringFlag{Name: `user-login`, Usage: ``},
+				cli.StringFlag{Name: `user-blog`, Usage: ``},
+				cli.IntFlag{Name: `user-collaborators`, Usage: ``},
 				cli.StringFlag{Name: `user-company`, Usage: ``},
+				cli.StringFlag{Name: `user-created-at`, Usage: ``},
+				cli.IntFlag{Name: `user-disk-usage`, Usage: ``},
 				cli.StringFlag{Name: `user-email`, Usage: ``},
-				cli.IntFlag{Name: `user-following`, Usage: ``},
-				cli.StringFlag{Name: `user-type`, Usage: ``},
-				cli.StringFlag{Name: `user-name`, Usage: ``},
-				cli.IntFlag{Name: `user-public-gists`, Usage: ``},
 				cli.IntFlag{Name: `user-followers`, Usage: ``},
-				cli.IntFlag{Name: `user-private-gists`, Usage: ``},
+				cli.IntFlag{Name: `user-following`, Usage: ``},
 				cli.StringFlag{Name: `user-gravatar-id`, Usage: ``},
-				cli.StringFlag{Name: `user-blog`, Usage: ``},
-				cli.StringFlag{Name: `user-created-at`, Usage: ``},
-				cli.IntFlag{Name: `user-total-private-repos`, Usage: ``},
+				cli.BoolFlag{Name: `user-hireable`, Usage: ``},
+				cli.IntFlag{Name: `user-id`, Usage: ``},
+				cli.StringFlag{Name: `user-location`, Usage: ``},
+				cli.StringFlag{Name: `user-login`, Usage: ``},
+				cli.StringFlag{Name: `user-name`, Usage: ``},
 				cli.IntFlag{Name: `user-owned-private-repos`, Usage: ``},
-				cli.IntFlag{Name: `user-collaborators`, Usage: ``},
-				cli.StringFlag{Name: `user-plan-name`, Usage: ``},
-				cli.IntFlag{Name: `user-plan-space`, Usage: ``},
 				cli.IntFlag{Name: `user-plan-collaborators`, Usage: ``},
+				cli.StringFlag{Name: `user-plan-name`, Usage: ``},
 				cli.IntFlag{Name: `user-plan-private-repos`, Usage: ``},
-				cli.IntFlag{Name: `user-id`, Usage: ``},
+				cli.IntFlag{Name: `user-plan-space`, Usage: ``},
+				cli.IntFlag{Name: `user-private-gists`, Usage: ``},
+				cli.IntFlag{Name: `user-public-gists`, Usage: ``},
 				cli.IntFlag{Name: `user-public-repos`, Usage: ``},
+				cli.BoolFlag{Name: `user-site-admin`, Usage: ``},
+				cli.IntFlag{Name: `user-total-private-repos`, Usage: ``},
+				cli.StringFlag{Name: `user-type`, Usage: ``},
 				cli.StringFlag{Name: `user-updated-at`, Usage: ``},
-				cli.StringFlag{Name: `created-at`, Usage: ``},
-				cli.StringFlag{Name: `updated-at`, Usage: ``},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the RepositoryComment, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 3 {
-					showHelp(c, "create-comment", "create-comment <owner> <repo> <sha>")
+				args := repoArgs(c, 1)
+				if len(args) < 3 {
+					showHelp(c, "create-comment", "create-comment [<owner> <repo>] <sha>")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				sha := c.Args().Get(2)
-				comment := &github.RepositoryComment{
-					CommitID:  github.String(c.String("commit-id")),
-					ID:        github.Int(c.Int("id")),
-					CreatedAt: timePointer(now.MustParse(c.String("created-at"))),
-					UpdatedAt: timePointer(now.MustParse(c.String("updated-at"))),
-					Body:      github.String(c.String("body")),
-					Path:      github.String(c.String("path")),
-					Position:  github.Int(c.Int("position")),
+				owner := args.Get(0)
+				repo := args.Get(1)
+				sha := args.Get(2)
+				comment := &github.RepositoryComment{}
+				check(decodeFile(c.String("body-file"), comment))
+				if c.IsSet("body") {
+					comment.Body = github.String(c.String("body"))
+				}
+				if c.IsSet("commit-id") {
+					comment.CommitID = github.String(c.String("commit-id"))
+				}
+				if c.IsSet("created-at") {
+					comment.CreatedAt = timePointer(timeFlag(c, "created-at"))
+				}
+				if c.IsSet("id") {
+					comment.ID = github.Int(c.Int("id"))
+				}
+				if c.IsSet("path") {
+					comment.Path = github.String(c.String("path"))
+				}
+				if c.IsSet("position") {
+					comment.Position = github.Int(c.Int("position"))
+				}
+				if c.IsSet("updated-at") {
+					comment.UpdatedAt = timePointer(timeFlag(c, "updated-at"))
 				}
 
 				result, res, err := app.gh.Repositories.CreateComment(owner, repo, sha, comment)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "RepositoryComment", result)
 
 			},
 		}, cli.Command{
@@ -897,18 +1070,19 @@ forks, sources, member.  Default is "all".`},
    GitHub API docs: http://developer.github.com/v3/repos/comments/#get-a-single-commit-comment`,
 			Flags: []cli.Flag{},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 3 {
-					showHelp(c, "get-comment", "get-comment <owner> <repo> <id>")
+				args := repoArgs(c, 1)
+				if len(args) < 3 {
+					showHelp(c, "get-comment", "get-comment [<owner> <repo>] <id>")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				id, err := strconv.Atoi(c.Args().Get(2))
+				owner := args.Get(0)
+				repo := args.Get(1)
+				id, err := strconv.Atoi(args.Get(2))
 				check(err)
 
 				result, res, err := app.gh.Repositories.GetComment(owner, repo, id)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "RepositoryComment", result)
 
 			},
 		}, cli.Command{
@@ -919,62 +1093,78 @@ forks, sources, member.  Default is "all".`},
    GitHub API docs: http://developer.github.com/v3/repos/comments/#update-a-commit-comment`,
 			Flags: []cli.Flag{
 				cli.StringFlag{Name: `body`, Usage: `User-mutable fields`},
+				cli.StringFlag{Name: `commit-id`, Usage: ``},
+				cli.StringFlag{Name: `created-at`, Usage: ``},
+				cli.IntFlag{Name: `id`, Usage: ``},
 				cli.StringFlag{Name: `path`, Usage: `User-initialized fields`},
 				cli.IntFlag{Name: `position`, Usage: ``},
-				cli.BoolFlag{Name: `user-hireable`, Usage: ``},
-				cli.StringFlag{Name: `user-login`, Usage: ``},
+				cli.StringFlag{Name: `updated-at`, Usage: ``},
+				cli.StringFlag{Name: `user-bio`, Usage: ``},
+				cli.StringFlag{Name: `user-blog`, Usage: ``},
+				cli.IntFlag{Name: `user-collaborators`, Usage: ``},
 				cli.StringFlag{Name: `user-company`, Usage: ``},
+				cli.StringFlag{Name: `user-created-at`, Usage: ``},
+				cli.IntFlag{Name: `user-disk-usage`, Usage: ``},
 				cli.StringFlag{Name: `user-email`, Usage: ``},
-				cli.IntFlag{Name: `user-following`, Usage: ``},
-				cli.StringFlag{Name: `user-type`, Usage: ``},
-				cli.StringFlag{Name: `user-name`, Usage: ``},
-				cli.IntFlag{Name: `user-public-gists`, Usage: ``},
 				cli.IntFlag{Name: `user-followers`, Usage: ``},
-				cli.IntFlag{Name: `user-private-gists`, Usage: ``},
+				cli.IntFlag{Name: `user-following`, Usage: ``},
 				cli.StringFlag{Name: `user-gravatar-id`, Usage: ``},
-				cli.StringFlag{Name: `user-blog`, Usage: ``},
-				cli.StringFlag{Name: `user-created-at`, Usage: ``},
-				cli.IntFlag{Name: `user-total-private-repos`, Usage: ``},
+				cli.BoolFlag{Name: `user-hireable`, Usage: ``},
+				cli.IntFlag{Name: `user-id`, Usage: ``},
+				cli.StringFlag{Name: `user-location`, Usage: ``},
+				cli.StringFlag{Name: `user-login`, Usage: ``},
+				cli.StringFlag{Name: `user-name`, Usage: ``},
 				cli.IntFlag{Name: `user-owned-private-repos`, Usage: ``},
-				cli.IntFlag{Name: `user-collaborators`, Usage: ``},
-				cli.IntFlag{Name: `user-plan-space`, Usage: ``},
 				cli.IntFlag{Name: `user-plan-collaborators`, Usage: ``},
-				cli.IntFlag{Name: `user-plan-private-repos`, Usage: ``},
 				cli.StringFlag{Name: `user-plan-name`, Usage: ``},
-				cli.IntFlag{Name: `user-id`, Usage: ``},
+				cli.IntFlag{Name: `user-plan-private-repos`, Usage: ``},
+				cli.IntFlag{Name: `user-plan-space`, Usage: ``},
+				cli.IntFlag{Name: `user-private-gists`, Usage: ``},
+				cli.IntFlag{Name: `user-public-gists`, Usage: ``},
 				cli.IntFlag{Name: `user-public-repos`, Usage: ``},
-				cli.StringFlag{Name: `user-updated-at`, Usage: ``},
-				cli.StringFlag{Name: `user-bio`, Usage: ``},
 				cli.BoolFlag{Name: `user-site-admin`, Usage: ``},
-				cli.StringFlag{Name: `user-location`, Usage: ``},
-				cli.IntFlag{Name: `user-disk-usage`, Usage: ``},
-				cli.StringFlag{Name: `created-at`, Usage: ``},
-				cli.StringFlag{Name: `updated-at`, Usage: ``},
-				cli.IntFlag{Name: `id`, Usage: ``},
-				cli.StringFlag{Name: `commit-id`, Usage: ``},
+				cli.IntFlag{Name: `user-total-private-repos`, Usage: ``},
+				cli.StringFlag{Name: `user-type`, Usage: ``},
+				cli.StringFlag{Name: `user-updated-at`, Usage: ``},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the RepositoryComment, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 3 {
-					showHelp(c, "update-comment", "update-comment <owner> <repo> <id>")
+				args := repoArgs(c, 1)
+				if len(args) < 3 {
+					showHelp(c, "update-comment", "update-comment [<owner> <repo>] <id>")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				id, err := strconv.Atoi(c.Args().Get(2))
+				owner := args.Get(0)
+				repo := args.Get(1)
+				id, err := strconv.Atoi(args.Get(2))
 				check(err)
-				comment := &github.RepositoryComment{
-					CreatedAt: timePointer(now.MustParse(c.String("created-at"))),
-					UpdatedAt: timePointer(now.MustParse(c.String("updated-at"))),
-					Body:      github.String(c.String("body")),
-					Path:      github.String(c.String("path")),
-					Position:  github.Int(c.Int("position")),
-					ID:        github.Int(c.Int("id")),
-					CommitID:  github.String(c.String("commit-id")),
+				comment := &github.RepositoryComment{}
+				check(decodeFile(c.String("body-file"), comment))
+				if c.IsSet("body") {
+					comment.Body = github.String(c.String("body"))
+				}
+				if c.IsSet("commit-id") {
+					comment.CommitID = github.String(c.String("commit-id"))
+				}
+				if c.IsSet("created-at") {
+					comment.CreatedAt = timePointer(timeFlag(c, "created-at"))
+				}
+				if c.IsSet("id") {
+					comment.ID = github.Int(c.Int("id"))
+				}
+				if c.IsSet("path") {
+					comment.Path = github.String(c.String("path"))
+				}
+				if c.IsSet("position") {
+					comment.Position = github.Int(c.Int("position"))
+				}
+				if c.IsSet("updated-at") {
+					comment.UpdatedAt = timePointer(timeFlag(c, "updated-at"))
 				}
 
 				result, res, err := app.gh.Repositories.UpdateComment(owner, repo, id, comment)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "RepositoryComment", result)
 
 			},
 		}, cli.Command{
@@ -983,19 +1173,24 @@ forks, sources, member.  Default is "all".`},
 			Description: `delete-comment deletes a single comment from a repository.
 
    GitHub API docs: http://developer.github.com/v3/repos/comments/#delete-a-commit-comment`,
-			Flags: []cli.Flag{},
+			Flags: []cli.Flag{
+				cli.BoolFlag{Name: `yes`, Usage: `Don't ask for confirmation`},
+			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 3 {
-					showHelp(c, "delete-comment", "delete-comment <owner> <repo> <id>")
+				args := repoArgs(c, 1)
+				if len(args) < 3 {
+					showHelp(c, "delete-comment", "delete-comment [<owner> <repo>] <id>")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				id, err := strconv.Atoi(c.Args().Get(2))
+				owner := args.Get(0)
+				repo := args.Get(1)
+				id, err := strconv.Atoi(args.Get(2))
 				check(err)
 
+				confirm(c, "delete-comment", repo)
+
 				res, err := app.gh.Repositories.DeleteComment(owner, repo, id)
-				checkResponse(res.Response, err)
+				checkResponse(res, err)
 
 			},
 		}, cli.Command{
@@ -1005,44 +1200,58 @@ forks, sources, member.  Default is "all".`},
 
    GitHub API docs: http://developer.github.com/v3/repos/commits/#list`,
 			Flags: []cli.Flag{
-				cli.StringFlag{Name: `until`, Usage: `Until when should Commits be included in the response.`},
+				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
+				cli.StringFlag{Name: `author`, Usage: `Author of by which to filter Commits.`},
+				cli.IntFlag{Name: `limit`, Usage: `Stop after this many items, fetching the following pages as needed`},
 				cli.IntFlag{Name: `page`, Usage: `For paginated result sets, page of results to retrieve.`},
+				cli.StringFlag{Name: `path`, Usage: `Path that should be touched by the returned Commits.`},
 				cli.IntFlag{Name: `per-page`, Usage: `For paginated result sets, the number of results to include per page.`},
-				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
 				cli.StringFlag{Name: `sha`, Usage: `SHA or branch to start listing Commits from.`},
-				cli.StringFlag{Name: `path`, Usage: `Path that should be touched by the returned Commits.`},
-				cli.StringFlag{Name: `author`, Usage: `Author of by which to filter Commits.`},
 				cli.StringFlag{Name: `since`, Usage: `Since when should Commits be included in the response.`},
+				cli.StringFlag{Name: `until`, Usage: `Until when should Commits be included in the response.`},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the CommitsListOptions, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 2 {
-					showHelp(c, "list-commits", "list-commits <owner> <repo>")
+				args := repoArgs(c, 0)
+				if len(args) < 2 {
+					showHelp(c, "list-commits", "list-commits [<owner> <repo>]")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				opt := &github.CommitsListOptions{
-					SHA:    c.String("sha"),
-					Path:   c.String("path"),
-					Author: c.String("author"),
-					Since:  now.MustParse(c.String("since")),
-					Until:  now.MustParse(c.String("until")),
+				owner := args.Get(0)
+				repo := args.Get(1)
+				opt := &github.CommitsListOptions{}
+				check(decodeFile(c.String("body-file"), opt))
+				if c.IsSet("author") {
+					opt.Author = c.String("author")
+				}
+				if c.IsSet("path") {
+					opt.Path = c.String("path")
+				}
+				if c.IsSet("sha") {
+					opt.SHA = c.String("sha")
+				}
+				if c.IsSet("since") {
+					opt.Since = timeFlag(c, "since")
+				}
+				if c.IsSet("until") {
+					opt.Until = timeFlag(c, "until")
+				}
+				if c.IsSet("page") {
+					opt.Page = c.Int("page")
+				}
+				if c.IsSet("per-page") {
+					opt.PerPage = c.Int("per-page")
 				}
 
-				var items []github.RepositoryCommit
-
-				for {
-					page, res, err := app.gh.Repositories.ListCommits(owner, repo, opt)
-					checkResponse(res.Response, err)
-
-					items = append(items, page...)
-					if res.NextPage == 0 || !c.Bool("all") {
-						break
-					}
-					opt.Page = res.NextPage
+				if opt.PerPage == 0 {
+					opt.PerPage = app.profile.PerPage
 				}
 
-				fmt.Printf("%# v", pretty.Formatter(items))
+				paginate(c, "RepositoryCommit", func(page int) (interface{}, *github.Response, error) {
+					opt := *opt
+					opt.Page = page
+					return app.gh.Repositories.ListCommits(owner, repo, &opt)
+				})
 			},
 		}, cli.Command{
 			Name:  "get-commit",
@@ -1054,17 +1263,18 @@ forks, sources, member.  Default is "all".`},
    See also: http://developer.github.com//v3/git/commits/#get-a-single-commit provides the same functionality`,
 			Flags: []cli.Flag{},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 3 {
-					showHelp(c, "get-commit", "get-commit <owner> <repo> <sha>")
+				args := repoArgs(c, 1)
+				if len(args) < 3 {
+					showHelp(c, "get-commit", "get-commit [<owner> <repo>] <sha>")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				sha := c.Args().Get(2)
+				owner := args.Get(0)
+				repo := args.Get(1)
+				sha := args.Get(2)
 
 				result, res, err := app.gh.Repositories.GetCommit(owner, repo, sha)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "RepositoryCommit", result)
 
 			},
 		}, cli.Command{
@@ -1076,18 +1286,19 @@ forks, sources, member.  Default is "all".`},
    GitHub API docs: http://developer.github.com/v3/repos/commits/index.html#compare-two-commits`,
 			Flags: []cli.Flag{},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 4 {
-					showHelp(c, "compare-commits", "compare-commits <owner> <repo> <base> <head>")
+				args := repoArgs(c, 2)
+				if len(args) < 4 {
+					showHelp(c, "compare-commits", "compare-commits [<owner> <repo>] <base> <head>")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				base := c.Args().Get(2)
-				head := c.Args().Get(3)
+				owner := args.Get(0)
+				repo := args.Get(1)
+				base := args.Get(2)
+				head := args.Get(3)
 
 				result, res, err := app.gh.Repositories.CompareCommits(owner, repo, base, head)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "CommitsComparison", result)
 
 			},
 		}, cli.Command{
@@ -1098,21 +1309,25 @@ forks, sources, member.  Default is "all".`},
    GitHub API docs: http://developer.github.com/v3/repos/contents/#get-the-readme`,
 			Flags: []cli.Flag{
 				cli.StringFlag{Name: `ref`, Usage: ``},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the RepositoryContentGetOptions, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 2 {
-					showHelp(c, "get-readme", "get-readme <owner> <repo>")
+				args := repoArgs(c, 0)
+				if len(args) < 2 {
+					showHelp(c, "get-readme", "get-readme [<owner> <repo>]")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				opt := &github.RepositoryContentGetOptions{
-					Ref: c.String("ref"),
+				owner := args.Get(0)
+				repo := args.Get(1)
+				opt := &github.RepositoryContentGetOptions{}
+				check(decodeFile(c.String("body-file"), opt))
+				if c.IsSet("ref") {
+					opt.Ref = c.String("ref")
 				}
 
 				result, res, err := app.gh.Repositories.GetReadme(owner, repo, opt)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "RepositoryContent", result)
 
 			},
 		}, cli.Command{
@@ -1124,28 +1339,63 @@ forks, sources, member.  Default is "all".`},
    responsibility to close the ReadCloser.`,
 			Flags: []cli.Flag{
 				cli.StringFlag{Name: `ref`, Usage: ``},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the RepositoryContentGetOptions, - to read from stdin. Flags take precedence over the file`},
+				cli.StringFlag{Name: `out`, Usage: `Write to this file instead of stdout`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 3 {
-					showHelp(c, "download-contents", "download-contents <owner> <repo> <filepath>")
+				args := repoArgs(c, 1)
+				if len(args) < 3 {
+					showHelp(c, "download-contents", "download-contents [<owner> <repo>] <filepath>")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				filepath := c.Args().Get(2)
-				opt := &github.RepositoryContentGetOptions{
-					Ref: c.String("ref"),
+				owner := args.Get(0)
+				repo := args.Get(1)
+				filepath := args.Get(2)
+				opt := &github.RepositoryContentGetOptions{}
+				check(decodeFile(c.String("body-file"), opt))
+				if c.IsSet("ref") {
+					opt.Ref = c.String("ref")
 				}
 
-				_, err := app.gh.Repositories.DownloadContents(owner, repo, filepath, opt)
+				app.stream()
+				body, err := app.gh.Repositories.DownloadContents(owner, repo, filepath, opt)
 				check(err)
-
+				download(c, body)
 			},
 		}, cli.Command{
 			Name:  "get-contents",
-			Usage: "not implemented",
+			Usage: `get-contents can return either the metadata and content of a single file (when path references a file) or the metadata of all the files and/or subdirectories of a directory (when path references a directory).`,
+			Description: `get-contents can return either the metadata and content of a single file
+   (when path references a file) or the metadata of all the files and/or
+   subdirectories of a directory (when path references a directory). To make it
+   easy to distinguish between both result types and to mimic the API as much
+   as possible, both result types will be returned but only one will contain a
+   value and the other will be nil.
+
+   GitHub API docs: http://developer.github.com/v3/repos/contents/#get-contents`,
+			Flags: []cli.Flag{
+				cli.StringFlag{Name: `ref`, Usage: ``},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the RepositoryContentGetOptions, - to read from stdin. Flags take precedence over the file`},
+				cli.BoolFlag{Name: `raw`, Usage: `Write the file bytes to stdout as is`},
+			},
 			Action: func(c *cli.Context) {
-				fatalln("Not implemented")
+				args := repoArgs(c, 1)
+				if len(args) < 3 {
+					showHelp(c, "get-contents", "get-contents [<owner> <repo>] <path>")
+				}
+
+				owner := args.Get(0)
+				repo := args.Get(1)
+				path := args.Get(2)
+				opt := &github.RepositoryContentGetOptions{}
+				check(decodeFile(c.String("body-file"), opt))
+				if c.IsSet("ref") {
+					opt.Ref = c.String("ref")
+				}
+
+				file, dir, res, err := app.gh.Repositories.GetContents(owner, repo, path, opt)
+				checkResponse(res, err)
+				renderFileOrDir(c, file, dir)
 			},
 		}, cli.Command{
 			Name:  "create-file",
@@ -1155,33 +1405,41 @@ forks, sources, member.  Default is "all".`},
 
    GitHub API docs: http://developer.github.com/v3/repos/contents/#create-a-file`,
 			Flags: []cli.Flag{
-				cli.StringFlag{Name: `sha`, Usage: ``},
-				cli.StringFlag{Name: `branch`, Usage: ``},
 				cli.StringFlag{Name: `author-date`, Usage: ``},
-				cli.StringFlag{Name: `author-name`, Usage: ``},
 				cli.StringFlag{Name: `author-email`, Usage: ``},
+				cli.StringFlag{Name: `author-name`, Usage: ``},
+				cli.StringFlag{Name: `branch`, Usage: ``},
 				cli.StringFlag{Name: `committer-date`, Usage: ``},
-				cli.StringFlag{Name: `committer-name`, Usage: ``},
 				cli.StringFlag{Name: `committer-email`, Usage: ``},
+				cli.StringFlag{Name: `committer-name`, Usage: ``},
 				cli.StringFlag{Name: `message`, Usage: ``},
+				cli.StringFlag{Name: `sha`, Usage: ``},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the RepositoryContentFileOptions, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 3 {
-					showHelp(c, "create-file", "create-file <owner> <repo> <path>")
+				args := repoArgs(c, 1)
+				if len(args) < 3 {
+					showHelp(c, "create-file", "create-file [<owner> <repo>] <path>")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				path := c.Args().Get(2)
-				opt := &github.RepositoryContentFileOptions{
-					Branch:  github.String(c.String("branch")),
-					Message: github.String(c.String("message")),
-					SHA:     github.String(c.String("sha")),
+				owner := args.Get(0)
+				repo := args.Get(1)
+				path := args.Get(2)
+				opt := &github.RepositoryContentFileOptions{}
+				check(decodeFile(c.String("body-file"), opt))
+				if c.IsSet("branch") {
+					opt.Branch = github.String(c.String("branch"))
+				}
+				if c.IsSet("message") {
+					opt.Message = github.String(c.String("message"))
+				}
+				if c.IsSet("sha") {
+					opt.SHA = github.String(c.String("sha"))
 				}
 
 				result, res, err := app.gh.Repositories.CreateFile(owner, repo, path, opt)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "RepositoryContentResponse", result)
 
 			},
 		}, cli.Command{
@@ -1192,33 +1450,41 @@ forks, sources, member.  Default is "all".`},
 
    GitHub API docs: http://developer.github.com/v3/repos/contents/#update-a-file`,
 			Flags: []cli.Flag{
-				cli.StringFlag{Name: `message`, Usage: ``},
-				cli.StringFlag{Name: `sha`, Usage: ``},
-				cli.StringFlag{Name: `branch`, Usage: ``},
 				cli.StringFlag{Name: `author-date`, Usage: ``},
-				cli.StringFlag{Name: `author-name`, Usage: ``},
 				cli.StringFlag{Name: `author-email`, Usage: ``},
+				cli.StringFlag{Name: `author-name`, Usage: ``},
+				cli.StringFlag{Name: `branch`, Usage: ``},
 				cli.StringFlag{Name: `committer-date`, Usage: ``},
-				cli.StringFlag{Name: `committer-name`, Usage: ``},
 				cli.StringFlag{Name: `committer-email`, Usage: ``},
+				cli.StringFlag{Name: `committer-name`, Usage: ``},
+				cli.StringFlag{Name: `message`, Usage: ``},
+				cli.StringFlag{Name: `sha`, Usage: ``},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the RepositoryContentFileOptions, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 3 {
-					showHelp(c, "update-file", "update-file <owner> <repo> <path>")
+				args := repoArgs(c, 1)
+				if len(args) < 3 {
+					showHelp(c, "update-file", "update-file [<owner> <repo>] <path>")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				path := c.Args().Get(2)
-				opt := &github.RepositoryContentFileOptions{
-					Branch:  github.String(c.String("branch")),
-					Message: github.String(c.String("message")),
-					SHA:     github.String(c.String("sha")),
+				owner := args.Get(0)
+				repo := args.Get(1)
+				path := args.Get(2)
+				opt := &github.RepositoryContentFileOptions{}
+				check(decodeFile(c.String("body-file"), opt))
+				if c.IsSet("branch") {
+					opt.Branch = github.String(c.String("branch"))
+				}
+				if c.IsSet("message") {
+					opt.Message = github.String(c.String("message"))
+				}
+				if c.IsSet("sha") {
+					opt.SHA = github.String(c.String("sha"))
 				}
 
 				result, res, err := app.gh.Repositories.UpdateFile(owner, repo, path, opt)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "RepositoryContentResponse", result)
 
 			},
 		}, cli.Command{
@@ -1229,33 +1495,44 @@ forks, sources, member.  Default is "all".`},
 
    GitHub API docs: http://developer.github.com/v3/repos/contents/#delete-a-file`,
 			Flags: []cli.Flag{
-				cli.StringFlag{Name: `message`, Usage: ``},
-				cli.StringFlag{Name: `sha`, Usage: ``},
-				cli.StringFlag{Name: `branch`, Usage: ``},
 				cli.StringFlag{Name: `author-date`, Usage: ``},
-				cli.StringFlag{Name: `author-name`, Usage: ``},
 				cli.StringFlag{Name: `author-email`, Usage: ``},
-				cli.StringFlag{Name: `committer-name`, Usage: ``},
-				cli.StringFlag{Name: `committer-email`, Usage: ``},
+				cli.StringFlag{Name: `author-name`, Usage: ``},
+				cli.StringFlag{Name: `branch`, Usage: ``},
 				cli.StringFlag{Name: `committer-date`, Usage: ``},
+				cli.StringFlag{Name: `committer-email`, Usage: ``},
+				cli.StringFlag{Name: `committer-name`, Usage: ``},
+				cli.StringFlag{Name: `message`, Usage: ``},
+				cli.StringFlag{Name: `sha`, Usage: ``},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the RepositoryContentFileOptions, - to read from stdin. Flags take precedence over the file`},
+				cli.BoolFlag{Name: `yes`, Usage: `Don't ask for confirmation`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 3 {
-					showHelp(c, "delete-file", "delete-file <owner> <repo> <path>")
+				args := repoArgs(c, 1)
+				if len(args) < 3 {
+					showHelp(c, "delete-file", "delete-file [<owner> <repo>] <path>")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				path := c.Args().Get(2)
-				opt := &github.RepositoryContentFileOptions{
-					SHA:     github.String(c.String("sha")),
-					Branch:  github.String(c.String("branch")),
-					Message: github.String(c.String("message")),
+				owner := args.Get(0)
+				repo := args.Get(1)
+				path := args.Get(2)
+				opt := &github.RepositoryContentFileOptions{}
+				check(decodeFile(c.String("body-file"), opt))
+				if c.IsSet("branch") {
+					opt.Branch = github.String(c.String("branch"))
+				}
+				if c.IsSet("message") {
+					opt.Message = github.String(c.String("message"))
 				}
+				if c.IsSet("sha") {
+					opt.SHA = github.String(c.String("sha"))
+				}
+
+				confirm(c, "delete-file", repo)
 
 				result, res, err := app.gh.Repositories.DeleteFile(owner, repo, path, opt)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "RepositoryContentResponse", result)
 
 			},
 		}, cli.Command{
@@ -1265,42 +1542,54 @@ forks, sources, member.  Default is "all".`},
 
    GitHub API docs: https://developer.github.com/v3/repos/deployments/#list-deployments`,
 			Flags: []cli.Flag{
-				cli.StringFlag{Name: `sha`, Usage: `SHA of the Deployment.`},
-				cli.StringFlag{Name: `ref`, Usage: `List deployments for a given ref.`},
-				cli.StringFlag{Name: `task`, Usage: `List deployments for a given task.`},
+				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
 				cli.StringFlag{Name: `environment`, Usage: `List deployments for a given environment.`},
+				cli.IntFlag{Name: `limit`, Usage: `Stop after this many items, fetching the following pages as needed`},
 				cli.IntFlag{Name: `page`, Usage: `For paginated result sets, page of results to retrieve.`},
 				cli.IntFlag{Name: `per-page`, Usage: `For paginated result sets, the number of results to include per page.`},
-				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
+				cli.StringFlag{Name: `ref`, Usage: `List deployments for a given ref.`},
+				cli.StringFlag{Name: `sha`, Usage: `SHA of the Deployment.`},
+				cli.StringFlag{Name: `task`, Usage: `List deployments for a given task.`},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the DeploymentsListOptions, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 2 {
-					showHelp(c, "list-deployments", "list-deployments <owner> <repo>")
+				args := repoArgs(c, 0)
+				if len(args) < 2 {
+					showHelp(c, "list-deployments", "list-deployments [<owner> <repo>]")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				opt := &github.DeploymentsListOptions{
-					SHA:         c.String("sha"),
-					Ref:         c.String("ref"),
-					Task:        c.String("task"),
-					Environment: c.String("environment"),
+				owner := args.Get(0)
+				repo := args.Get(1)
+				opt := &github.DeploymentsListOptions{}
+				check(decodeFile(c.String("body-file"), opt))
+				if c.IsSet("environment") {
+					opt.Environment = c.String("environment")
+				}
+				if c.IsSet("ref") {
+					opt.Ref = c.String("ref")
+				}
+				if c.IsSet("sha") {
+					opt.SHA = c.String("sha")
+				}
+				if c.IsSet("task") {
+					opt.Task = c.String("task")
+				}
+				if c.IsSet("page") {
+					opt.Page = c.Int("page")
+				}
+				if c.IsSet("per-page") {
+					opt.PerPage = c.Int("per-page")
 				}
 
-				var items []github.Deployment
-
-				for {
-					page, res, err := app.gh.Repositories.ListDeployments(owner, repo, opt)
-					checkResponse(res.Response, err)
-
-					items = append(items, page...)
-					if res.NextPage == 0 || !c.Bool("all") {
-						break
-					}
-					opt.Page = res.NextPage
+				if opt.PerPage == 0 {
+					opt.PerPage = app.profile.PerPage
 				}
 
-				fmt.Printf("%# v", pretty.Formatter(items))
+				paginate(c, "Deployment", func(page int) (interface{}, *github.Response, error) {
+					opt := *opt
+					opt.Page = page
+					return app.gh.Repositories.ListDeployments(owner, repo, &opt)
+				})
 			},
 		}, cli.Command{
 			Name:  "create-deployment",
@@ -1309,34 +1598,50 @@ forks, sources, member.  Default is "all".`},
 
    GitHub API docs: https://developer.github.com/v3/repos/deployments/#create-a-deployment`,
 			Flags: []cli.Flag{
-				cli.StringSliceFlag{Name: `required-contexts`, Usage: ``},
-				cli.StringFlag{Name: `payload`, Usage: ``},
-				cli.StringFlag{Name: `environment`, Usage: ``},
+				cli.BoolFlag{Name: `auto-merge`, Usage: ``},
 				cli.StringFlag{Name: `description`, Usage: ``},
+				cli.StringFlag{Name: `environment`, Usage: ``},
+				cli.StringFlag{Name: `payload`, Usage: ``},
 				cli.StringFlag{Name: `ref`, Usage: ``},
+				cli.StringSliceFlag{Name: `required-contexts`, Usage: ``},
 				cli.StringFlag{Name: `task`, Usage: ``},
-				cli.BoolFlag{Name: `auto-merge`, Usage: ``},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the DeploymentRequest, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 2 {
-					showHelp(c, "create-deployment", "create-deployment <owner> <repo>")
+				args := repoArgs(c, 0)
+				if len(args) < 2 {
+					showHelp(c, "create-deployment", "create-deployment [<owner> <repo>]")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				request := &github.DeploymentRequest{
-					Task:             github.String(c.String("task")),
-					AutoMerge:        github.Bool(c.Bool("auto-merge")),
-					RequiredContexts: stringSlicePointer(c.StringSlice("required-contexts")),
-					Payload:          github.String(c.String("payload")),
-					Environment:      github.String(c.String("environment")),
-					Description:      github.String(c.String("description")),
-					Ref:              github.String(c.String("ref")),
+				owner := args.Get(0)
+				repo := args.Get(1)
+				request := &github.DeploymentRequest{}
+				check(decodeFile(c.String("body-file"), request))
+				if c.IsSet("auto-merge") {
+					request.AutoMerge = github.Bool(c.Bool("auto-merge"))
+				}
+				if c.IsSet("description") {
+					request.Description = github.String(c.String("description"))
+				}
+				if c.IsSet("environment") {
+					request.Environment = github.String(c.String("environment"))
+				}
+				if c.IsSet("payload") {
+					request.Payload = github.String(c.String("payload"))
+				}
+				if c.IsSet("ref") {
+					request.Ref = github.String(c.String("ref"))
+				}
+				if c.IsSet("required-contexts") {
+					request.RequiredContexts = stringSlicePointer(c.StringSlice("required-contexts"))
+				}
+				if c.IsSet("task") {
+					request.Task = github.String(c.String("task"))
 				}
 
 				result, res, err := app.gh.Repositories.CreateDeployment(owner, repo, request)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "Deployment", result)
 
 			},
 		}, cli.Command{
@@ -1349,35 +1654,37 @@ forks, sources, member.  Default is "all".`},
 				cli.IntFlag{Name: `page`, Usage: `For paginated result sets, page of results to retrieve.`},
 				cli.IntFlag{Name: `per-page`, Usage: `For paginated result sets, the number of results to include per page.`},
 				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
+				cli.IntFlag{Name: `limit`, Usage: `Stop after this many items, fetching the following pages as needed`},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the ListOptions, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 3 {
-					showHelp(c, "list-deployment-statuses", "list-deployment-statuses <owner> <repo> <deployment>")
+				args := repoArgs(c, 1)
+				if len(args) < 3 {
+					showHelp(c, "list-deployment-statuses", "list-deployment-statuses [<owner> <repo>] <deployment>")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				deployment, err := strconv.Atoi(c.Args().Get(2))
+				owner := args.Get(0)
+				repo := args.Get(1)
+				deployment, err := strconv.Atoi(args.Get(2))
 				check(err)
-				opt := &github.ListOptions{
-					Page:    c.Int("page"),
-					PerPage: c.Int("per-page"),
+				opt := &github.ListOptions{}
+				check(decodeFile(c.String("body-file"), opt))
+				if c.IsSet("page") {
+					opt.Page = c.Int("page")
+				}
+				if c.IsSet("per-page") {
+					opt.PerPage = c.Int("per-page")
 				}
 
-				var items []github.DeploymentStatus
-
-				for {
-					page, res, err := app.gh.Repositories.ListDeploymentStatuses(owner, repo, deployment, opt)
-					checkResponse(res.Response, err)
-
-					items = append(items, page...)
-					if res.NextPage == 0 || !c.Bool("all") {
-						break
-					}
-					opt.Page = res.NextPage
+				if opt.PerPage == 0 {
+					opt.PerPage = app.profile.PerPage
 				}
 
-				fmt.Printf("%# v", pretty.Formatter(items))
+				paginate(c, "DeploymentStatus", func(page int) (interface{}, *github.Response, error) {
+					opt := *opt
+					opt.Page = page
+					return app.gh.Repositories.ListDeploymentStatuses(owner, repo, deployment, &opt)
+				})
 			},
 		}, cli.Command{
 			Name:  "create-deployment-status",
@@ -1386,26 +1693,32 @@ forks, sources, member.  Default is "all".`},
 
    GitHub API docs: https://developer.github.com/v3/repos/deployments/#create-a-deployment-status`,
 			Flags: []cli.Flag{
-				cli.StringFlag{Name: `state`, Usage: ``},
 				cli.StringFlag{Name: `description`, Usage: ``},
+				cli.StringFlag{Name: `state`, Usage: ``},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the DeploymentStatusRequest, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 3 {
-					showHelp(c, "create-deployment-status", "create-deployment-status <owner> <repo> <deployment>")
+				args := repoArgs(c, 1)
+				if len(args) < 3 {
+					showHelp(c, "create-deployment-status", "create-deployment-status [<owner> <repo>] <deployment>")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				deployment, err := strconv.Atoi(c.Args().Get(2))
+				owner := args.Get(0)
+				repo := args.Get(1)
+				deployment, err := strconv.Atoi(args.Get(2))
 				check(err)
-				request := &github.DeploymentStatusRequest{
-					State:       github.String(c.String("state")),
-					Description: github.String(c.String("description")),
+				request := &github.DeploymentStatusRequest{}
+				check(decodeFile(c.String("body-file"), request))
+				if c.IsSet("description") {
+					request.Description = github.String(c.String("description"))
+				}
+				if c.IsSet("state") {
+					request.State = github.String(c.String("state"))
 				}
 
 				result, res, err := app.gh.Repositories.CreateDeploymentStatus(owner, repo, deployment, request)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "DeploymentStatus", result)
 
 			},
 		}, cli.Command{
@@ -1415,37 +1728,43 @@ forks, sources, member.  Default is "all".`},
 
    GitHub API docs: http://developer.github.com/v3/repos/forks/#list-forks`,
 			Flags: []cli.Flag{
-				cli.StringFlag{Name: `sort`, Usage: `How to sort the forks list.  Possible values are: newest, oldest,
-watchers.  Default is "newest".`},
+				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
+				cli.IntFlag{Name: `limit`, Usage: `Stop after this many items, fetching the following pages as needed`},
 				cli.IntFlag{Name: `page`, Usage: `For paginated result sets, page of results to retrieve.`},
 				cli.IntFlag{Name: `per-page`, Usage: `For paginated result sets, the number of results to include per page.`},
-				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
+				cli.StringFlag{Name: `sort`, Usage: `How to sort the forks list.  Possible values are: newest, oldest,
+watchers.  Default is "newest".`},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the RepositoryListForksOptions, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 2 {
-					showHelp(c, "list-forks", "list-forks <owner> <repo>")
+				args := repoArgs(c, 0)
+				if len(args) < 2 {
+					showHelp(c, "list-forks", "list-forks [<owner> <repo>]")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				opt := &github.RepositoryListForksOptions{
-					Sort: c.String("sort"),
+				owner := args.Get(0)
+				repo := args.Get(1)
+				opt := &github.RepositoryListForksOptions{}
+				check(decodeFile(c.String("body-file"), opt))
+				if c.IsSet("sort") {
+					opt.Sort = c.String("sort")
+				}
+				if c.IsSet("page") {
+					opt.Page = c.Int("page")
+				}
+				if c.IsSet("per-page") {
+					opt.PerPage = c.Int("per-page")
 				}
 
-				var items []github.Repository
-
-				for {
-					page, res, err := app.gh.Repositories.ListForks(owner, repo, opt)
-					checkResponse(res.Response, err)
-
-					items = append(items, page...)
-					if res.NextPage == 0 || !c.Bool("all") {
-						break
-					}
-					opt.Page = res.NextPage
+				if opt.PerPage == 0 {
+					opt.PerPage = app.profile.PerPage
 				}
 
-				fmt.Printf("%# v", pretty.Formatter(items))
+				paginate(c, "Repository", func(page int) (interface{}, *github.Response, error) {
+					opt := *opt
+					opt.Page = page
+					return app.gh.Repositories.ListForks(owner, repo, &opt)
+				})
 			},
 		}, cli.Command{
 			Name:  "create-fork",
@@ -1455,21 +1774,25 @@ watchers.  Default is "newest".`},
    GitHub API docs: http://developer.github.com/v3/repos/forks/#list-forks`,
 			Flags: []cli.Flag{
 				cli.StringFlag{Name: `organization`, Usage: `The organization to fork the repository into.`},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the RepositoryCreateForkOptions, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 2 {
-					showHelp(c, "create-fork", "create-fork <owner> <repo>")
+				args := repoArgs(c, 0)
+				if len(args) < 2 {
+					showHelp(c, "create-fork", "create-fork [<owner> <repo>]")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				opt := &github.RepositoryCreateForkOptions{
-					Organization: c.String("organization"),
+				owner := args.Get(0)
+				repo := args.Get(1)
+				opt := &github.RepositoryCreateForkOptions{}
+				check(decodeFile(c.String("body-file"), opt))
+				if c.IsSet("organization") {
+					opt.Organization = c.String("organization")
 				}
 
 				result, res, err := app.gh.Repositories.CreateFork(owner, repo, opt)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "Repository", result)
 
 			},
 		}, cli.Command{
@@ -1480,32 +1803,46 @@ watchers.  Default is "newest".`},
 
    GitHub API docs: http://developer.github.com/v3/repos/hooks/#create-a-hook`,
 			Flags: []cli.Flag{
-				cli.StringSliceFlag{Name: `events`, Usage: ``},
 				cli.BoolFlag{Name: `active`, Usage: ``},
-				cli.IntFlag{Name: `id`, Usage: ``},
 				cli.StringFlag{Name: `created-at`, Usage: ``},
-				cli.StringFlag{Name: `updated-at`, Usage: ``},
+				cli.StringSliceFlag{Name: `events`, Usage: ``},
+				cli.IntFlag{Name: `id`, Usage: ``},
 				cli.StringFlag{Name: `name`, Usage: ``},
+				cli.StringFlag{Name: `updated-at`, Usage: ``},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the Hook, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 2 {
-					showHelp(c, "create-hook", "create-hook <owner> <repo>")
+				args := repoArgs(c, 0)
+				if len(args) < 2 {
+					showHelp(c, "create-hook", "create-hook [<owner> <repo>]")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				hook := &github.Hook{
-					ID:        github.Int(c.Int("id")),
-					CreatedAt: timePointer(now.MustParse(c.String("created-at"))),
-					UpdatedAt: timePointer(now.MustParse(c.String("updated-at"))),
-					Name:      github.String(c.String("name")),
-					Events:    c.StringSlice("events"),
-					Active:    github.Bool(c.Bool("active")),
+				owner := args.Get(0)
+				repo := args.Get(1)
+				hook := &github.Hook{}
+				check(decodeFile(c.String("body-file"), hook))
+				if c.IsSet("active") {
+					hook.Active = github.Bool(c.Bool("active"))
+				}
+				if c.IsSet("created-at") {
+					hook.CreatedAt = timePointer(timeFlag(c, "created-at"))
+				}
+				if c.IsSet("events") {
+					hook.Events = c.StringSlice("events")
+				}
+				if c.IsSet("id") {
+					hook.ID = github.Int(c.Int("id"))
+				}
+				if c.IsSet("name") {
+					hook.Name = github.String(c.String("name"))
+				}
+				if c.IsSet("updated-at") {
+					hook.UpdatedAt = timePointer(timeFlag(c, "updated-at"))
 				}
 
 				result, res, err := app.gh.Repositories.CreateHook(owner, repo, hook)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "Hook", result)
 
 			},
 		}, cli.Command{
@@ -1518,33 +1855,35 @@ watchers.  Default is "newest".`},
 				cli.IntFlag{Name: `page`, Usage: `For paginated result sets, page of results to retrieve.`},
 				cli.IntFlag{Name: `per-page`, Usage: `For paginated result sets, the number of results to include per page.`},
 				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
+				cli.IntFlag{Name: `limit`, Usage: `Stop after this many items, fetching the following pages as needed`},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the ListOptions, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 2 {
-					showHelp(c, "list-hooks", "list-hooks <owner> <repo>")
+				args := repoArgs(c, 0)
+				if len(args) < 2 {
+					showHelp(c, "list-hooks", "list-hooks [<owner> <repo>]")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				opt := &github.ListOptions{
-					PerPage: c.Int("per-page"),
-					Page:    c.Int("page"),
+				owner := args.Get(0)
+				repo := args.Get(1)
+				opt := &github.ListOptions{}
+				check(decodeFile(c.String("body-file"), opt))
+				if c.IsSet("page") {
+					opt.Page = c.Int("page")
+				}
+				if c.IsSet("per-page") {
+					opt.PerPage = c.Int("per-page")
 				}
 
-				var items []github.Hook
-
-				for {
-					page, res, err := app.gh.Repositories.ListHooks(owner, repo, opt)
-					checkResponse(res.Response, err)
-
-					items = append(items, page...)
-					if res.NextPage == 0 || !c.Bool("all") {
-						break
-					}
-					opt.Page = res.NextPage
+				if opt.PerPage == 0 {
+					opt.PerPage = app.profile.PerPage
 				}
 
-				fmt.Printf("%# v", pretty.Formatter(items))
+				paginate(c, "Hook", func(page int) (interface{}, *github.Response, error) {
+					opt := *opt
+					opt.Page = page
+					return app.gh.Repositories.ListHooks(owner, repo, &opt)
+				})
 			},
 		}, cli.Command{
 			Name:  "get-hook",
@@ -1554,18 +1893,19 @@ watchers.  Default is "newest".`},
    GitHub API docs: http://developer.github.com/v3/repos/hooks/#get-single-hook`,
 			Flags: []cli.Flag{},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 3 {
-					showHelp(c, "get-hook", "get-hook <owner> <repo> <id>")
+				args := repoArgs(c, 1)
+				if len(args) < 3 {
+					showHelp(c, "get-hook", "get-hook [<owner> <repo>] <id>")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				id, err := strconv.Atoi(c.Args().Get(2))
+				owner := args.Get(0)
+				repo := args.Get(1)
+				id, err := strconv.Atoi(args.Get(2))
 				check(err)
 
 				result, res, err := app.gh.Repositories.GetHook(owner, repo, id)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "Hook", result)
 
 			},
 		}, cli.Command{
@@ -1575,34 +1915,48 @@ watchers.  Default is "newest".`},
 
    GitHub API docs: http://developer.github.com/v3/repos/hooks/#edit-a-hook`,
 			Flags: []cli.Flag{
-				cli.IntFlag{Name: `id`, Usage: ``},
+				cli.BoolFlag{Name: `active`, Usage: ``},
 				cli.StringFlag{Name: `created-at`, Usage: ``},
-				cli.StringFlag{Name: `updated-at`, Usage: ``},
-				cli.StringFlag{Name: `name`, Usage: ``},
 				cli.StringSliceFlag{Name: `events`, Usage: ``},
-				cli.BoolFlag{Name: `active`, Usage: ``},
+				cli.IntFlag{Name: `id`, Usage: ``},
+				cli.StringFlag{Name: `name`, Usage: ``},
+				cli.StringFlag{Name: `updated-at`, Usage: ``},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the Hook, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 3 {
-					showHelp(c, "edit-hook", "edit-hook <owner> <repo> <id>")
+				args := repoArgs(c, 1)
+				if len(args) < 3 {
+					showHelp(c, "edit-hook", "edit-hook [<owner> <repo>] <id>")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				id, err := strconv.Atoi(c.Args().Get(2))
+				owner := args.Get(0)
+				repo := args.Get(1)
+				id, err := strconv.Atoi(args.Get(2))
 				check(err)
-				hook := &github.Hook{
-					Name:      github.String(c.String("name")),
-					Events:    c.StringSlice("events"),
-					Active:    github.Bool(c.Bool("active")),
-					ID:        github.Int(c.Int("id")),
-					CreatedAt: timePointer(now.MustParse(c.String("created-at"))),
-					UpdatedAt: timePointer(now.MustParse(c.String("updated-at"))),
+				hook := &github.Hook{}
+				check(decodeFile(c.String("body-file"), hook))
+				if c.IsSet("active") {
+					hook.Active = github.Bool(c.Bool("active"))
+				}
+				if c.IsSet("created-at") {
+					hook.CreatedAt = timePointer(timeFlag(c, "created-at"))
+				}
+				if c.IsSet("events") {
+					hook.Events = c.StringSlice("events")
+				}
+				if c.IsSet("id") {
+					hook.ID = github.Int(c.Int("id"))
+				}
+				if c.IsSet("name") {
+					hook.Name = github.String(c.String("name"))
+				}
+				if c.IsSet("updated-at") {
+					hook.UpdatedAt = timePointer(timeFlag(c, "updated-at"))
 				}
 
 				result, res, err := app.gh.Repositories.EditHook(owner, repo, id, hook)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "Hook", result)
 
 			},
 		}, cli.Command{
@@ -1611,19 +1965,24 @@ watchers.  Default is "newest".`},
 			Description: `delete-hook deletes a specified Hook.
 
    GitHub API docs: http://developer.github.com/v3/repos/hooks/#delete-a-hook`,
-			Flags: []cli.Flag{},
+			Flags: []cli.Flag{
+				cli.BoolFlag{Name: `yes`, Usage: `Don't ask for confirmation`},
+			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 3 {
-					showHelp(c, "delete-hook", "delete-hook <owner> <repo> <id>")
+				args := repoArgs(c, 1)
+				if len(args) < 3 {
+					showHelp(c, "delete-hook", "delete-hook [<owner> <repo>] <id>")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				id, err := strconv.Atoi(c.Args().Get(2))
+				owner := args.Get(0)
+				repo := args.Get(1)
+				id, err := strconv.Atoi(args.Get(2))
 				check(err)
 
+				confirm(c, "delete-hook", repo)
+
 				res, err := app.gh.Repositories.DeleteHook(owner, repo, id)
-				checkResponse(res.Response, err)
+				checkResponse(res, err)
 
 			},
 		}, cli.Command{
@@ -1634,17 +1993,18 @@ watchers.  Default is "newest".`},
    GitHub API docs: https://developer.github.com/v3/repos/hooks/#ping-a-hook`,
 			Flags: []cli.Flag{},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 3 {
-					showHelp(c, "ping-hook", "ping-hook <owner> <repo> <id>")
+				args := repoArgs(c, 1)
+				if len(args) < 3 {
+					showHelp(c, "ping-hook", "ping-hook [<owner> <repo>] <id>")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				id, err := strconv.Atoi(c.Args().Get(2))
+				owner := args.Get(0)
+				repo := args.Get(1)
+				id, err := strconv.Atoi(args.Get(2))
 				check(err)
 
 				res, err := app.gh.Repositories.PingHook(owner, repo, id)
-				checkResponse(res.Response, err)
+				checkResponse(res, err)
 
 			},
 		}, cli.Command{
@@ -1655,17 +2015,18 @@ watchers.  Default is "newest".`},
    GitHub API docs: http://developer.github.com/v3/repos/hooks/#test-a-push-hook`,
 			Flags: []cli.Flag{},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 3 {
-					showHelp(c, "test-hook", "test-hook <owner> <repo> <id>")
+				args := repoArgs(c, 1)
+				if len(args) < 3 {
+					showHelp(c, "test-hook", "test-hook [<owner> <repo>] <id>")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				id, err := strconv.Atoi(c.Args().Get(2))
+				owner := args.Get(0)
+				repo := args.Get(1)
+				id, err := strconv.Atoi(args.Get(2))
 				check(err)
 
 				res, err := app.gh.Repositories.TestHook(owner, repo, id)
-				checkResponse(res.Response, err)
+				checkResponse(res, err)
 
 			},
 		}, cli.Command{
@@ -1676,8 +2037,8 @@ watchers.  Default is "newest".`},
 			Action: func(c *cli.Context) {
 
 				result, res, err := app.gh.Repositories.ListServiceHooks()
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "ServiceHook", result)
 
 			},
 		}, cli.Command{
@@ -1690,33 +2051,35 @@ watchers.  Default is "newest".`},
 				cli.IntFlag{Name: `page`, Usage: `For paginated result sets, page of results to retrieve.`},
 				cli.IntFlag{Name: `per-page`, Usage: `For paginated result sets, the number of results to include per page.`},
 				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
+				cli.IntFlag{Name: `limit`, Usage: `Stop after this many items, fetching the following pages as needed`},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the ListOptions, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 2 {
-					showHelp(c, "list-keys", "list-keys <owner> <repo>")
+				args := repoArgs(c, 0)
+				if len(args) < 2 {
+					showHelp(c, "list-keys", "list-keys [<owner> <repo>]")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				opt := &github.ListOptions{
-					PerPage: c.Int("per-page"),
-					Page:    c.Int("page"),
+				owner := args.Get(0)
+				repo := args.Get(1)
+				opt := &github.ListOptions{}
+				check(decodeFile(c.String("body-file"), opt))
+				if c.IsSet("page") {
+					opt.Page = c.Int("page")
+				}
+				if c.IsSet("per-page") {
+					opt.PerPage = c.Int("per-page")
 				}
 
-				var items []github.Key
-
-				for {
-					page, res, err := app.gh.Repositories.ListKeys(owner, repo, opt)
-					checkResponse(res.Response, err)
-
-					items = append(items, page...)
-					if res.NextPage == 0 || !c.Bool("all") {
-						break
-					}
-					opt.Page = res.NextPage
+				if opt.PerPage == 0 {
+					opt.PerPage = app.profile.PerPage
 				}
 
-				fmt.Printf("%# v", pretty.Formatter(items))
+				paginate(c, "Key", func(page int) (interface{}, *github.Response, error) {
+					opt := *opt
+					opt.Page = page
+					return app.gh.Repositories.ListKeys(owner, repo, &opt)
+				})
 			},
 		}, cli.Command{
 			Name:  "get-key",
@@ -1726,18 +2089,19 @@ watchers.  Default is "newest".`},
    GitHub API docs: http://developer.github.com/v3/repos/keys/#get`,
 			Flags: []cli.Flag{},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 3 {
-					showHelp(c, "get-key", "get-key <owner> <repo> <id>")
+				args := repoArgs(c, 1)
+				if len(args) < 3 {
+					showHelp(c, "get-key", "get-key [<owner> <repo>] <id>")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				id, err := strconv.Atoi(c.Args().Get(2))
+				owner := args.Get(0)
+				repo := args.Get(1)
+				id, err := strconv.Atoi(args.Get(2))
 				check(err)
 
 				result, res, err := app.gh.Repositories.GetKey(owner, repo, id)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "Key", result)
 
 			},
 		}, cli.Command{
@@ -1747,26 +2111,34 @@ watchers.  Default is "newest".`},
 
    GitHub API docs: http://developer.github.com/v3/repos/keys/#create`,
 			Flags: []cli.Flag{
-				cli.StringFlag{Name: `title`, Usage: ``},
 				cli.IntFlag{Name: `id`, Usage: ``},
 				cli.StringFlag{Name: `key`, Usage: ``},
+				cli.StringFlag{Name: `title`, Usage: ``},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the Key, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 2 {
-					showHelp(c, "create-key", "create-key <owner> <repo>")
+				args := repoArgs(c, 0)
+				if len(args) < 2 {
+					showHelp(c, "create-key", "create-key [<owner> <repo>]")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				key := &github.Key{
-					ID:    github.Int(c.Int("id")),
-					Key:   github.String(c.String("key")),
-					Title: github.String(c.String("title")),
+				owner := args.Get(0)
+				repo := args.Get(1)
+				key := &github.Key{}
+				check(decodeFile(c.String("body-file"), key))
+				if c.IsSet("id") {
+					key.ID = github.Int(c.Int("id"))
+				}
+				if c.IsSet("key") {
+					key.Key = github.String(c.String("key"))
+				}
+				if c.IsSet("title") {
+					key.Title = github.String(c.String("title"))
 				}
 
 				result, res, err := app.gh.Repositories.CreateKey(owner, repo, key)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "Key", result)
 
 			},
 		}, cli.Command{
@@ -1779,25 +2151,33 @@ watchers.  Default is "newest".`},
 				cli.IntFlag{Name: `id`, Usage: ``},
 				cli.StringFlag{Name: `key`, Usage: ``},
 				cli.StringFlag{Name: `title`, Usage: ``},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the Key, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 3 {
-					showHelp(c, "edit-key", "edit-key <owner> <repo> <id>")
+				args := repoArgs(c, 1)
+				if len(args) < 3 {
+					showHelp(c, "edit-key", "edit-key [<owner> <repo>] <id>")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				id, err := strconv.Atoi(c.Args().Get(2))
+				owner := args.Get(0)
+				repo := args.Get(1)
+				id, err := strconv.Atoi(args.Get(2))
 				check(err)
-				key := &github.Key{
-					Key:   github.String(c.String("key")),
-					Title: github.String(c.String("title")),
-					ID:    github.Int(c.Int("id")),
+				key := &github.Key{}
+				check(decodeFile(c.String("body-file"), key))
+				if c.IsSet("id") {
+					key.ID = github.Int(c.Int("id"))
+				}
+				if c.IsSet("key") {
+					key.Key = github.String(c.String("key"))
+				}
+				if c.IsSet("title") {
+					key.Title = github.String(c.String("title"))
 				}
 
 				result, res, err := app.gh.Repositories.EditKey(owner, repo, id, key)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "Key", result)
 
 			},
 		}, cli.Command{
@@ -1806,19 +2186,24 @@ watchers.  Default is "newest".`},
 			Description: `delete-key deletes a deploy key.
 
    GitHub API docs: http://developer.github.com/v3/repos/keys/#delete`,
-			Flags: []cli.Flag{},
+			Flags: []cli.Flag{
+				cli.BoolFlag{Name: `yes`, Usage: `Don't ask for confirmation`},
+			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 3 {
-					showHelp(c, "delete-key", "delete-key <owner> <repo> <id>")
+				args := repoArgs(c, 1)
+				if len(args) < 3 {
+					showHelp(c, "delete-key", "delete-key [<owner> <repo>] <id>")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				id, err := strconv.Atoi(c.Args().Get(2))
+				owner := args.Get(0)
+				repo := args.Get(1)
+				id, err := strconv.Atoi(args.Get(2))
 				check(err)
 
+				confirm(c, "delete-key", repo)
+
 				res, err := app.gh.Repositories.DeleteKey(owner, repo, id)
-				checkResponse(res.Response, err)
+				checkResponse(res, err)
 
 			},
 		}, cli.Command{
@@ -1828,26 +2213,34 @@ watchers.  Default is "newest".`},
 
    GitHub API docs: https://developer.github.com/v3/repos/merging/#perform-a-merge`,
 			Flags: []cli.Flag{
-				cli.StringFlag{Name: `commit-message`, Usage: ``},
 				cli.StringFlag{Name: `base`, Usage: ``},
+				cli.StringFlag{Name: `commit-message`, Usage: ``},
 				cli.StringFlag{Name: `head`, Usage: ``},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the RepositoryMergeRequest, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 2 {
-					showHelp(c, "merge", "merge <owner> <repo>")
+				args := repoArgs(c, 0)
+				if len(args) < 2 {
+					showHelp(c, "merge", "merge [<owner> <repo>]")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				request := &github.RepositoryMergeRequest{
-					Base:          github.String(c.String("base")),
-					Head:          github.String(c.String("head")),
-					CommitMessage: github.String(c.String("commit-message")),
+				owner := args.Get(0)
+				repo := args.Get(1)
+				request := &github.RepositoryMergeRequest{}
+				check(decodeFile(c.String("body-file"), request))
+				if c.IsSet("base") {
+					request.Base = github.String(c.String("base"))
+				}
+				if c.IsSet("commit-message") {
+					request.CommitMessage = github.String(c.String("commit-message"))
+				}
+				if c.IsSet("head") {
+					request.Head = github.String(c.String("head"))
 				}
 
 				result, res, err := app.gh.Repositories.Merge(owner, repo, request)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "RepositoryCommit", result)
 
 			},
 		}, cli.Command{
@@ -1858,16 +2251,17 @@ watchers.  Default is "newest".`},
    GitHub API docs: https://developer.github.com/v3/repos/pages/#get-information-about-a-pages-site`,
 			Flags: []cli.Flag{},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 2 {
-					showHelp(c, "get-pages-info", "get-pages-info <owner> <repo>")
+				args := repoArgs(c, 0)
+				if len(args) < 2 {
+					showHelp(c, "get-pages-info", "get-pages-info [<owner> <repo>]")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
+				owner := args.Get(0)
+				repo := args.Get(1)
 
 				result, res, err := app.gh.Repositories.GetPagesInfo(owner, repo)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "Pages", result)
 
 			},
 		}, cli.Command{
@@ -1878,16 +2272,17 @@ watchers.  Default is "newest".`},
    GitHub API docs: https://developer.github.com/v3/repos/pages/#list-pages-builds`,
 			Flags: []cli.Flag{},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 2 {
-					showHelp(c, "list-pages-builds", "list-pages-builds <owner> <repo>")
+				args := repoArgs(c, 0)
+				if len(args) < 2 {
+					showHelp(c, "list-pages-builds", "list-pages-builds [<owner> <repo>]")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
+				owner := args.Get(0)
+				repo := args.Get(1)
 
 				result, res, err := app.gh.Repositories.ListPagesBuilds(owner, repo)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "PagesBuild", result)
 
 			},
 		}, cli.Command{
@@ -1898,16 +2293,17 @@ watchers.  Default is "newest".`},
    GitHub API docs: https://developer.github.com/v3/repos/pages/#list-latest-pages-build`,
 			Flags: []cli.Flag{},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 2 {
-					showHelp(c, "get-latest-pages-build", "get-latest-pages-build <owner> <repo>")
+				args := repoArgs(c, 0)
+				if len(args) < 2 {
+					showHelp(c, "get-latest-pages-build", "get-latest-pages-build [<owner> <repo>]")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
+				owner := args.Get(0)
+				repo := args.Get(1)
 
 				result, res, err := app.gh.Repositories.GetLatestPagesBuild(owner, repo)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "PagesBuild", result)
 
 			},
 		}, cli.Command{
@@ -1917,36 +2313,38 @@ watchers.  Default is "newest".`},
 
    GitHub API docs: http://developer.github.com/v3/repos/releases/#list-releases-for-a-repository`,
 			Flags: []cli.Flag{
-				cli.IntFlag{Name: `per-page`, Usage: `For paginated result sets, the number of results to include per page.`},
 				cli.IntFlag{Name: `page`, Usage: `For paginated result sets, page of results to retrieve.`},
+				cli.IntFlag{Name: `per-page`, Usage: `For paginated result sets, the number of results to include per page.`},
 				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
+				cli.IntFlag{Name: `limit`, Usage: `Stop after this many items, fetching the following pages as needed`},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the ListOptions, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 2 {
-					showHelp(c, "list-releases", "list-releases <owner> <repo>")
+				args := repoArgs(c, 0)
+				if len(args) < 2 {
+					showHelp(c, "list-releases", "list-releases [<owner> <repo>]")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				opt := &github.ListOptions{
-					Page:    c.Int("page"),
-					PerPage: c.Int("per-page"),
+				owner := args.Get(0)
+				repo := args.Get(1)
+				opt := &github.ListOptions{}
+				check(decodeFile(c.String("body-file"), opt))
+				if c.IsSet("page") {
+					opt.Page = c.Int("page")
+				}
+				if c.IsSet("per-page") {
+					opt.PerPage = c.Int("per-page")
 				}
 
-				var items []github.RepositoryRelease
-
-				for {
-					page, res, err := app.gh.Repositories.ListReleases(owner, repo, opt)
-					checkResponse(res.Response, err)
-
-					items = append(items, page...)
-					if res.NextPage == 0 || !c.Bool("all") {
-						break
-					}
-					opt.Page = res.NextPage
+				if opt.PerPage == 0 {
+					opt.PerPage = app.profile.PerPage
 				}
 
-				fmt.Printf("%# v", pretty.Formatter(items))
+				paginate(c, "RepositoryRelease", func(page int) (interface{}, *github.Response, error) {
+					opt := *opt
+					opt.Page = page
+					return app.gh.Repositories.ListReleases(owner, repo, &opt)
+				})
 			},
 		}, cli.Command{
 			Name:  "get-release",
@@ -1956,18 +2354,19 @@ watchers.  Default is "newest".`},
    GitHub API docs: http://developer.github.com/v3/repos/releases/#get-a-single-release`,
 			Flags: []cli.Flag{},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 3 {
-					showHelp(c, "get-release", "get-release <owner> <repo> <id>")
+				args := repoArgs(c, 1)
+				if len(args) < 3 {
+					showHelp(c, "get-release", "get-release [<owner> <repo>] <id>")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				id, err := strconv.Atoi(c.Args().Get(2))
+				owner := args.Get(0)
+				repo := args.Get(1)
+				id, err := strconv.Atoi(args.Get(2))
 				check(err)
 
 				result, res, err := app.gh.Repositories.GetRelease(owner, repo, id)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "RepositoryRelease", result)
 
 			},
 		}, cli.Command{
@@ -1978,16 +2377,17 @@ watchers.  Default is "newest".`},
    GitHub API docs: https://developer.github.com/v3/repos/releases/#get-the-latest-release`,
 			Flags: []cli.Flag{},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 2 {
-					showHelp(c, "get-latest-release", "get-latest-release <owner> <repo>")
+				args := repoArgs(c, 0)
+				if len(args) < 2 {
+					showHelp(c, "get-latest-release", "get-latest-release [<owner> <repo>]")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
+				owner := args.Get(0)
+				repo := args.Get(1)
 
 				result, res, err := app.gh.Repositories.GetLatestRelease(owner, repo)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "RepositoryRelease", result)
 
 			},
 		}, cli.Command{
@@ -1998,17 +2398,18 @@ watchers.  Default is "newest".`},
    GitHub API docs: https://developer.github.com/v3/repos/releases/#get-a-release-by-tag-name`,
 			Flags: []cli.Flag{},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 3 {
-					showHelp(c, "get-release-by-tag", "get-release-by-tag <owner> <repo> <tag>")
+				args := repoArgs(c, 1)
+				if len(args) < 3 {
+					showHelp(c, "get-release-by-tag", "get-release-by-tag [<owner> <repo>] <tag>")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				tag := c.Args().Get(2)
+				owner := args.Get(0)
+				repo := args.Get(1)
+				tag := args.Get(2)
 
 				result, res, err := app.gh.Repositories.GetReleaseByTag(owner, repo, tag)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "RepositoryRelease", result)
 
 			},
 		}, cli.Command{
@@ -2018,38 +2419,58 @@ watchers.  Default is "newest".`},
 
    GitHub API docs : http://developer.github.com/v3/repos/releases/#create-a-release`,
 			Flags: []cli.Flag{
+				cli.StringFlag{Name: `body`, Usage: ``},
+				cli.StringFlag{Name: `created-at`, Usage: ``},
+				cli.BoolFlag{Name: `draft`, Usage: ``},
 				cli.IntFlag{Name: `id`, Usage: ``},
 				cli.StringFlag{Name: `name`, Usage: ``},
-				cli.BoolFlag{Name: `draft`, Usage: ``},
+				cli.BoolFlag{Name: `prerelease`, Usage: ``},
 				cli.StringFlag{Name: `published-at`, Usage: ``},
 				cli.StringFlag{Name: `tag-name`, Usage: ``},
-				cli.BoolFlag{Name: `prerelease`, Usage: ``},
-				cli.StringFlag{Name: `created-at`, Usage: ``},
 				cli.StringFlag{Name: `target-commitish`, Usage: ``},
-				cli.StringFlag{Name: `body`, Usage: ``},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the RepositoryRelease, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 2 {
-					showHelp(c, "create-release", "create-release <owner> <repo>")
+				args := repoArgs(c, 0)
+				if len(args) < 2 {
+					showHelp(c, "create-release", "create-release [<owner> <repo>]")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				release := &github.RepositoryRelease{
-					CreatedAt:       &github.Timestamp{now.MustParse(c.String("created-at"))},
-					TargetCommitish: github.String(c.String("target-commitish")),
-					Body:            github.String(c.String("body")),
-					ID:              github.Int(c.Int("id")),
-					Name:            github.String(c.String("name")),
-					Draft:           github.Bool(c.Bool("draft")),
-					PublishedAt:     &github.Timestamp{now.MustParse(c.String("published-at"))},
-					TagName:         github.String(c.String("tag-name")),
-					Prerelease:      github.Bool(c.Bool("prerelease")),
+				owner := args.Get(0)
+				repo := args.Get(1)
+				release := &github.RepositoryRelease{}
+				check(decodeFile(c.String("body-file"), release))
+				if c.IsSet("body") {
+					release.Body = github.String(c.String("body"))
+				}
+				if c.IsSet("created-at") {
+					release.CreatedAt = &github.Timestamp{Time: timeFlag(c, "created-at")}
+				}
+				if c.IsSet("draft") {
+					release.Draft = github.Bool(c.Bool("draft"))
+				}
+				if c.IsSet("id") {
+					release.ID = github.Int(c.Int("id"))
+				}
+				if c.IsSet("name") {
+					release.Name = github.String(c.String("name"))
+				}
+				if c.IsSet("prerelease") {
+					release.Prerelease = github.Bool(c.Bool("prerelease"))
+				}
+				if c.IsSet("published-at") {
+					release.PublishedAt = &github.Timestamp{Time: timeFlag(c, "published-at")}
+				}
+				if c.IsSet("tag-name") {
+					release.TagName = github.String(c.String("tag-name"))
+				}
+				if c.IsSet("target-commitish") {
+					release.TargetCommitish = github.String(c.String("target-commitish"))
 				}
 
 				result, res, err := app.gh.Repositories.CreateRelease(owner, repo, release)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "RepositoryRelease", result)
 
 			},
 		}, cli.Command{
@@ -2059,40 +2480,60 @@ watchers.  Default is "newest".`},
 
    GitHub API docs : http://developer.github.com/v3/repos/releases/#edit-a-release`,
 			Flags: []cli.Flag{
-				cli.StringFlag{Name: `target-commitish`, Usage: ``},
 				cli.StringFlag{Name: `body`, Usage: ``},
+				cli.StringFlag{Name: `created-at`, Usage: ``},
+				cli.BoolFlag{Name: `draft`, Usage: ``},
 				cli.IntFlag{Name: `id`, Usage: ``},
 				cli.StringFlag{Name: `name`, Usage: ``},
-				cli.BoolFlag{Name: `draft`, Usage: ``},
+				cli.BoolFlag{Name: `prerelease`, Usage: ``},
 				cli.StringFlag{Name: `published-at`, Usage: ``},
 				cli.StringFlag{Name: `tag-name`, Usage: ``},
-				cli.BoolFlag{Name: `prerelease`, Usage: ``},
-				cli.StringFlag{Name: `created-at`, Usage: ``},
+				cli.StringFlag{Name: `target-commitish`, Usage: ``},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the RepositoryRelease, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 3 {
-					showHelp(c, "edit-release", "edit-release <owner> <repo> <id>")
+				args := repoArgs(c, 1)
+				if len(args) < 3 {
+					showHelp(c, "edit-release", "edit-release [<owner> <repo>] <id>")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				id, err := strconv.Atoi(c.Args().Get(2))
+				owner := args.Get(0)
+				repo := args.Get(1)
+				id, err := strconv.Atoi(args.Get(2))
 				check(err)
-				release := &github.RepositoryRelease{
-					ID:              github.Int(c.Int("id")),
-					Name:            github.String(c.String("name")),
-					Draft:           github.Bool(c.Bool("draft")),
-					PublishedAt:     &github.Timestamp{now.MustParse(c.String("published-at"))},
-					TagName:         github.String(c.String("tag-name")),
-					Prerelease:      github.Bool(c.Bool("prerelease")),
-					CreatedAt:       &github.Timestamp{now.MustParse(c.String("created-at"))},
-					TargetCommitish: github.String(c.String("target-commitish")),
-					Body:            github.String(c.String("body")),
+				release := &github.RepositoryRelease{}
+				check(decodeFile(c.String("body-file"), release))
+				if c.IsSet("body") {
+					release.Body = github.String(c.String("body"))
+				}
+				if c.IsSet("created-at") {
+					release.CreatedAt = &github.Timestamp{Time: timeFlag(c, "created-at")}
+				}
+				if c.IsSet("draft") {
+					release.Draft = github.Bool(c.Bool("draft"))
+				}
+				if c.IsSet("id") {
+					release.ID = github.Int(c.Int("id"))
+				}
+				if c.IsSet("name") {
+					release.Name = github.String(c.String("name"))
+				}
+				if c.IsSet("prerelease") {
+					release.Prerelease = github.Bool(c.Bool("prerelease"))
+				}
+				if c.IsSet("published-at") {
+					release.PublishedAt = &github.Timestamp{Time: timeFlag(c, "published-at")}
+				}
+				if c.IsSet("tag-name") {
+					release.TagName = github.String(c.String("tag-name"))
+				}
+				if c.IsSet("target-commitish") {
+					release.TargetCommitish = github.String(c.String("target-commitish"))
 				}
 
 				result, res, err := app.gh.Repositories.EditRelease(owner, repo, id, release)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "RepositoryRelease", result)
 
 			},
 		}, cli.Command{
@@ -2101,19 +2542,24 @@ watchers.  Default is "newest".`},
 			Description: `delete-release delete a single release from a repository.
 
    GitHub API docs : http://developer.github.com/v3/repos/releases/#delete-a-release`,
-			Flags: []cli.Flag{},
+			Flags: []cli.Flag{
+				cli.BoolFlag{Name: `yes`, Usage: `Don't ask for confirmation`},
+			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 3 {
-					showHelp(c, "delete-release", "delete-release <owner> <repo> <id>")
+				args := repoArgs(c, 1)
+				if len(args) < 3 {
+					showHelp(c, "delete-release", "delete-release [<owner> <repo>] <id>")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				id, err := strconv.Atoi(c.Args().Get(2))
+				owner := args.Get(0)
+				repo := args.Get(1)
+				id, err := strconv.Atoi(args.Get(2))
 				check(err)
 
+				confirm(c, "delete-release", repo)
+
 				res, err := app.gh.Repositories.DeleteRelease(owner, repo, id)
-				checkResponse(res.Response, err)
+				checkResponse(res, err)
 
 			},
 		}, cli.Command{
@@ -2126,35 +2572,37 @@ watchers.  Default is "newest".`},
 				cli.IntFlag{Name: `page`, Usage: `For paginated result sets, page of results to retrieve.`},
 				cli.IntFlag{Name: `per-page`, Usage: `For paginated result sets, the number of results to include per page.`},
 				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
+				cli.IntFlag{Name: `limit`, Usage: `Stop after this many items, fetching the following pages as needed`},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the ListOptions, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 3 {
-					showHelp(c, "list-release-assets", "list-release-assets <owner> <repo> <id>")
+				args := repoArgs(c, 1)
+				if len(args) < 3 {
+					showHelp(c, "list-release-assets", "list-release-assets [<owner> <repo>] <id>")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				id, err := strconv.Atoi(c.Args().Get(2))
+				owner := args.Get(0)
+				repo := args.Get(1)
+				id, err := strconv.Atoi(args.Get(2))
 				check(err)
-				opt := &github.ListOptions{
-					Page:    c.Int("page"),
-					PerPage: c.Int("per-page"),
+				opt := &github.ListOptions{}
+				check(decodeFile(c.String("body-file"), opt))
+				if c.IsSet("page") {
+					opt.Page = c.Int("page")
+				}
+				if c.IsSet("per-page") {
+					opt.PerPage = c.Int("per-page")
 				}
 
-				var items []github.ReleaseAsset
-
-				for {
-					page, res, err := app.gh.Repositories.ListReleaseAssets(owner, repo, id, opt)
-					checkResponse(res.Response, err)
-
-					items = append(items, page...)
-					if res.NextPage == 0 || !c.Bool("all") {
-						break
-					}
-					opt.Page = res.NextPage
+				if opt.PerPage == 0 {
+					opt.PerPage = app.profile.PerPage
 				}
 
-				fmt.Printf("%# v", pretty.Formatter(items))
+				paginate(c, "ReleaseAsset", func(page int) (interface{}, *github.Response, error) {
+					opt := *opt
+					opt.Page = page
+					return app.gh.Repositories.ListReleaseAssets(owner, repo, id, &opt)
+				})
 			},
 		}, cli.Command{
 			Name:  "get-release-asset",
@@ -2164,18 +2612,19 @@ watchers.  Default is "newest".`},
    GitHub API docs : http://developer.github.com/v3/repos/releases/#get-a-single-release-asset`,
 			Flags: []cli.Flag{},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 3 {
-					showHelp(c, "get-release-asset", "get-release-asset <owner> <repo> <id>")
+				args := repoArgs(c, 1)
+				if len(args) < 3 {
+					showHelp(c, "get-release-asset", "get-release-asset [<owner> <repo>] <id>")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				id, err := strconv.Atoi(c.Args().Get(2))
+				owner := args.Get(0)
+				repo := args.Get(1)
+				id, err := strconv.Atoi(args.Get(2))
 				check(err)
 
 				result, res, err := app.gh.Repositories.GetReleaseAsset(owner, repo, id)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "ReleaseAsset", result)
 
 			},
 		}, cli.Command{
@@ -2185,67 +2634,87 @@ watchers.  Default is "newest".`},
 
    GitHub API docs : http://developer.github.com/v3/repos/releases/#edit-a-release-asset`,
 			Flags: []cli.Flag{
+				cli.StringFlag{Name: `content-type`, Usage: ``},
+				cli.StringFlag{Name: `created-at`, Usage: ``},
+				cli.IntFlag{Name: `download-count`, Usage: ``},
 				cli.IntFlag{Name: `id`, Usage: ``},
+				cli.StringFlag{Name: `label`, Usage: ``},
 				cli.StringFlag{Name: `name`, Usage: ``},
-				cli.StringFlag{Name: `created-at`, Usage: ``},
+				cli.IntFlag{Name: `size`, Usage: ``},
+				cli.StringFlag{Name: `state`, Usage: ``},
 				cli.StringFlag{Name: `updated-at`, Usage: ``},
-				cli.IntFlag{Name: `uploader-following`, Usage: ``},
-				cli.StringFlag{Name: `uploader-type`, Usage: ``},
-				cli.StringFlag{Name: `uploader-login`, Usage: ``},
+				cli.StringFlag{Name: `uploader-bio`, Usage: ``},
+				cli.StringFlag{Name: `uploader-blog`, Usage: ``},
+				cli.IntFlag{Name: `uploader-collaborators`, Usage: ``},
 				cli.StringFlag{Name: `uploader-company`, Usage: ``},
+				cli.StringFlag{Name: `uploader-created-at`, Usage: ``},
+				cli.IntFlag{Name: `uploader-disk-usage`, Usage: ``},
 				cli.StringFlag{Name: `uploader-email`, Usage: ``},
-				cli.StringFlag{Name: `uploader-name`, Usage: ``},
-				cli.IntFlag{Name: `uploader-public-gists`, Usage: ``},
 				cli.IntFlag{Name: `uploader-followers`, Usage: ``},
-				cli.IntFlag{Name: `uploader-private-gists`, Usage: ``},
+				cli.IntFlag{Name: `uploader-following`, Usage: ``},
+				cli.StringFlag{Name: `uploader-gravatar-id`, Usage: ``},
+				cli.BoolFlag{Name: `uploader-hireable`, Usage: ``},
+				cli.IntFlag{Name: `uploader-id`, Usage: ``},
+				cli.StringFlag{Name: `uploader-location`, Usage: ``},
+				cli.StringFlag{Name: `uploader-login`, Usage: ``},
+				cli.StringFlag{Name: `uploader-name`, Usage: ``},
 				cli.IntFlag{Name: `uploader-owned-private-repos`, Usage: ``},
-				cli.IntFlag{Name: `uploader-collaborators`, Usage: ``},
-				cli.StringFlag{Name: `uploader-plan-name`, Usage: ``},
-				cli.IntFlag{Name: `uploader-plan-space`, Usage: ``},
 				cli.IntFlag{Name: `uploader-plan-collaborators`, Usage: ``},
+				cli.StringFlag{Name: `uploader-plan-name`, Usage: ``},
 				cli.IntFlag{Name: `uploader-plan-private-repos`, Usage: ``},
-				cli.StringFlag{Name: `uploader-gravatar-id`, Usage: ``},
-				cli.StringFlag{Name: `uploader-blog`, Usage: ``},
-				cli.StringFlag{Name: `uploader-created-at`, Usage: ``},
-				cli.IntFlag{Name: `uploader-total-private-repos`, Usage: ``},
-				cli.IntFlag{Name: `uploader-id`, Usage: ``},
+				cli.IntFlag{Name: `uploader-plan-space`, Usage: ``},
+				cli.IntFlag{Name: `uploader-private-gists`, Usage: ``},
+				cli.IntFlag{Name: `uploader-public-gists`, Usage: ``},
 				cli.IntFlag{Name: `uploader-public-repos`, Usage: ``},
-				cli.StringFlag{Name: `uploader-updated-at`, Usage: ``},
-				cli.StringFlag{Name: `uploader-bio`, Usage: ``},
 				cli.BoolFlag{Name: `uploader-site-admin`, Usage: ``},
-				cli.StringFlag{Name: `uploader-location`, Usage: ``},
-				cli.IntFlag{Name: `uploader-disk-usage`, Usage: ``},
-				cli.BoolFlag{Name: `uploader-hireable`, Usage: ``},
-				cli.StringFlag{Name: `label`, Usage: ``},
-				cli.StringFlag{Name: `state`, Usage: ``},
-				cli.StringFlag{Name: `content-type`, Usage: ``},
-				cli.IntFlag{Name: `size`, Usage: ``},
-				cli.IntFlag{Name: `download-count`, Usage: ``},
+				cli.IntFlag{Name: `uploader-total-private-repos`, Usage: ``},
+				cli.StringFlag{Name: `uploader-type`, Usage: ``},
+				cli.StringFlag{Name: `uploader-updated-at`, Usage: ``},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the ReleaseAsset, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 3 {
-					showHelp(c, "edit-release-asset", "edit-release-asset <owner> <repo> <id>")
+				args := repoArgs(c, 1)
+				if len(args) < 3 {
+					showHelp(c, "edit-release-asset", "edit-release-asset [<owner> <repo>] <id>")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				id, err := strconv.Atoi(c.Args().Get(2))
+				owner := args.Get(0)
+				repo := args.Get(1)
+				id, err := strconv.Atoi(args.Get(2))
 				check(err)
-				release := &github.ReleaseAsset{
-					Label:         github.String(c.String("label")),
-					State:         github.String(c.String("state")),
-					ContentType:   github.String(c.String("content-type")),
-					Size:          github.Int(c.Int("size")),
-					DownloadCount: github.Int(c.Int("download-count")),
-					ID:            github.Int(c.Int("id")),
-					Name:          github.String(c.String("name")),
-					CreatedAt:     &github.Timestamp{now.MustParse(c.String("created-at"))},
-					UpdatedAt:     &github.Timestamp{now.MustParse(c.String("updated-at"))},
+				release := &github.ReleaseAsset{}
+				check(decodeFile(c.String("body-file"), release))
+				if c.IsSet("content-type") {
+					release.ContentType = github.String(c.String("content-type"))
+				}
+				if c.IsSet("created-at") {
+					release.CreatedAt = &github.Timestamp{Time: timeFlag(c, "created-at")}
+				}
+				if c.IsSet("download-count") {
+					release.DownloadCount = github.Int(c.Int("download-count"))
+				}
+				if c.IsSet("id") {
+					release.ID = github.Int(c.Int("id"))
+				}
+				if c.IsSet("label") {
+					release.Label = github.String(c.String("label"))
+				}
+				if c.IsSet("name") {
+					release.Name = github.String(c.String("name"))
+				}
+				if c.IsSet("size") {
+					release.Size = github.Int(c.Int("size"))
+				}
+				if c.IsSet("state") {
+					release.State = github.String(c.String("state"))
+				}
+				if c.IsSet("updated-at") {
+					release.UpdatedAt = &github.Timestamp{Time: timeFlag(c, "updated-at")}
 				}
 
 				result, res, err := app.gh.Repositories.EditReleaseAsset(owner, repo, id, release)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "ReleaseAsset", result)
 
 			},
 		}, cli.Command{
@@ -2254,19 +2723,24 @@ watchers.  Default is "newest".`},
 			Description: `delete-release-asset delete a single release asset from a repository.
 
    GitHub API docs : http://developer.github.com/v3/repos/releases/#delete-a-release-asset`,
-			Flags: []cli.Flag{},
+			Flags: []cli.Flag{
+				cli.BoolFlag{Name: `yes`, Usage: `Don't ask for confirmation`},
+			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 3 {
-					showHelp(c, "delete-release-asset", "delete-release-asset <owner> <repo> <id>")
+				args := repoArgs(c, 1)
+				if len(args) < 3 {
+					showHelp(c, "delete-release-asset", "delete-release-asset [<owner> <repo>] <id>")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				id, err := strconv.Atoi(c.Args().Get(2))
+				owner := args.Get(0)
+				repo := args.Get(1)
+				id, err := strconv.Atoi(args.Get(2))
 				check(err)
 
+				confirm(c, "delete-release-asset", repo)
+
 				res, err := app.gh.Repositories.DeleteReleaseAsset(owner, repo, id)
-				checkResponse(res.Response, err)
+				checkResponse(res, err)
 
 			},
 		}, cli.Command{
@@ -2278,25 +2752,29 @@ watchers.  Default is "newest".`},
    GitHub API docs : http://developer.github.com/v3/repos/releases/#upload-a-release-asset`,
 			Flags: []cli.Flag{
 				cli.StringFlag{Name: `name`, Usage: ``},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the UploadOptions, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 4 {
-					showHelp(c, "upload-release-asset", "upload-release-asset <owner> <repo> <id> <file>")
+				args := repoArgs(c, 2)
+				if len(args) < 4 {
+					showHelp(c, "upload-release-asset", "upload-release-asset [<owner> <repo>] <id> <file>")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				id, err := strconv.Atoi(c.Args().Get(2))
+				owner := args.Get(0)
+				repo := args.Get(1)
+				id, err := strconv.Atoi(args.Get(2))
 				check(err)
-				opt := &github.UploadOptions{
-					Name: c.String("name"),
+				opt := &github.UploadOptions{}
+				check(decodeFile(c.String("body-file"), opt))
+				if c.IsSet("name") {
+					opt.Name = c.String("name")
 				}
-				file, err := os.Open(c.Args().Get(4))
+				file, err := os.Open(args.Get(4))
 				check(err)
 
 				result, res, err := app.gh.Repositories.UploadReleaseAsset(owner, repo, id, opt, file)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "ReleaseAsset", result)
 
 			},
 		}, cli.Command{
@@ -2314,16 +2792,17 @@ watchers.  Default is "newest".`},
    GitHub API Docs: https://developer.github.com/v3/repos/statistics/#contributors`,
 			Flags: []cli.Flag{},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 2 {
-					showHelp(c, "list-contributors-stats", "list-contributors-stats <owner> <repo>")
+				args := repoArgs(c, 0)
+				if len(args) < 2 {
+					showHelp(c, "list-contributors-stats", "list-contributors-stats [<owner> <repo>]")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
+				owner := args.Get(0)
+				repo := args.Get(1)
 
 				result, res, err := app.gh.Repositories.ListContributorsStats(owner, repo)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "ContributorStats", result)
 
 			},
 		}, cli.Command{
@@ -2342,16 +2821,17 @@ watchers.  Default is "newest".`},
    GitHub API Docs: https://developer.github.com/v3/repos/statistics/#commit-activity`,
 			Flags: []cli.Flag{},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 2 {
-					showHelp(c, "list-commit-activity", "list-commit-activity <owner> <repo>")
+				args := repoArgs(c, 0)
+				if len(args) < 2 {
+					showHelp(c, "list-commit-activity", "list-commit-activity [<owner> <repo>]")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
+				owner := args.Get(0)
+				repo := args.Get(1)
 
 				result, res, err := app.gh.Repositories.ListCommitActivity(owner, repo)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "WeeklyCommitActivity", result)
 
 			},
 		}, cli.Command{
@@ -2364,16 +2844,17 @@ watchers.  Default is "newest".`},
    GitHub API Docs: https://developer.github.com/v3/repos/statistics/#code-frequency`,
 			Flags: []cli.Flag{},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 2 {
-					showHelp(c, "list-code-frequency", "list-code-frequency <owner> <repo>")
+				args := repoArgs(c, 0)
+				if len(args) < 2 {
+					showHelp(c, "list-code-frequency", "list-code-frequency [<owner> <repo>]")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
+				owner := args.Get(0)
+				repo := args.Get(1)
 
 				result, res, err := app.gh.Repositories.ListCodeFrequency(owner, repo)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "WeeklyStats", result)
 
 			},
 		}, cli.Command{
@@ -2396,16 +2877,17 @@ watchers.  Default is "newest".`},
    GitHub API Docs: https://developer.github.com/v3/repos/statistics/#participation`,
 			Flags: []cli.Flag{},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 2 {
-					showHelp(c, "list-participation", "list-participation <owner> <repo>")
+				args := repoArgs(c, 0)
+				if len(args) < 2 {
+					showHelp(c, "list-participation", "list-participation [<owner> <repo>]")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
+				owner := args.Get(0)
+				repo := args.Get(1)
 
 				result, res, err := app.gh.Repositories.ListParticipation(owner, repo)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "RepositoryParticipation", result)
 
 			},
 		}, cli.Command{
@@ -2416,16 +2898,17 @@ watchers.  Default is "newest".`},
    GitHub API Docs: https://developer.github.com/v3/repos/statistics/#punch-card`,
 			Flags: []cli.Flag{},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 2 {
-					showHelp(c, "list-punch-card", "list-punch-card <owner> <repo>")
+				args := repoArgs(c, 0)
+				if len(args) < 2 {
+					showHelp(c, "list-punch-card", "list-punch-card [<owner> <repo>]")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
+				owner := args.Get(0)
+				repo := args.Get(1)
 
 				result, res, err := app.gh.Repositories.ListPunchCard(owner, repo)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "PunchCard", result)
 
 			},
 		}, cli.Command{
@@ -2439,34 +2922,36 @@ watchers.  Default is "newest".`},
 				cli.IntFlag{Name: `page`, Usage: `For paginated result sets, page of results to retrieve.`},
 				cli.IntFlag{Name: `per-page`, Usage: `For paginated result sets, the number of results to include per page.`},
 				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
+				cli.IntFlag{Name: `limit`, Usage: `Stop after this many items, fetching the following pages as needed`},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the ListOptions, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 3 {
-					showHelp(c, "list-statuses", "list-statuses <owner> <repo> <ref>")
+				args := repoArgs(c, 1)
+				if len(args) < 3 {
+					showHelp(c, "list-statuses", "list-statuses [<owner> <repo>] <ref>")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				ref := c.Args().Get(2)
-				opt := &github.ListOptions{
-					Page:    c.Int("page"),
-					PerPage: c.Int("per-page"),
+				owner := args.Get(0)
+				repo := args.Get(1)
+				ref := args.Get(2)
+				opt := &github.ListOptions{}
+				check(decodeFile(c.String("body-file"), opt))
+				if c.IsSet("page") {
+					opt.Page = c.Int("page")
+				}
+				if c.IsSet("per-page") {
+					opt.PerPage = c.Int("per-page")
 				}
 
-				var items []github.RepoStatus
-
-				for {
-					page, res, err := app.gh.Repositories.ListStatuses(owner, repo, ref, opt)
-					checkResponse(res.Response, err)
-
-					items = append(items, page...)
-					if res.NextPage == 0 || !c.Bool("all") {
-						break
-					}
-					opt.Page = res.NextPage
+				if opt.PerPage == 0 {
+					opt.PerPage = app.profile.PerPage
 				}
 
-				fmt.Printf("%# v", pretty.Formatter(items))
+				paginate(c, "RepoStatus", func(page int) (interface{}, *github.Response, error) {
+					opt := *opt
+					opt.Page = page
+					return app.gh.Repositories.ListStatuses(owner, repo, ref, &opt)
+				})
 			},
 		}, cli.Command{
 			Name:  "create-status",
@@ -2477,60 +2962,74 @@ watchers.  Default is "newest".`},
    GitHub API docs: http://developer.github.com/v3/repos/statuses/#create-a-status`,
 			Flags: []cli.Flag{
 				cli.StringFlag{Name: `context`, Usage: `A string label to differentiate this status from the statuses of other systems.`},
-				cli.StringFlag{Name: `updated-at`, Usage: ``},
-				cli.IntFlag{Name: `id`, Usage: ``},
-				cli.StringFlag{Name: `state`, Usage: `State is the current state of the repository.  Possible values are:
-pending, success, error, or failure.`},
-				cli.StringFlag{Name: `description`, Usage: `Description is a short high level summary of the status.`},
+				cli.StringFlag{Name: `created-at`, Usage: ``},
+				cli.StringFlag{Name: `creator-bio`, Usage: ``},
+				cli.StringFlag{Name: `creator-blog`, Usage: ``},
+				cli.IntFlag{Name: `creator-collaborators`, Usage: ``},
+				cli.StringFlag{Name: `creator-company`, Usage: ``},
+				cli.StringFlag{Name: `creator-created-at`, Usage: ``},
+				cli.IntFlag{Name: `creator-disk-usage`, Usage: ``},
 				cli.StringFlag{Name: `creator-email`, Usage: ``},
+				cli.IntFlag{Name: `creator-followers`, Usage: ``},
 				cli.IntFlag{Name: `creator-following`, Usage: ``},
-				cli.StringFlag{Name: `creator-type`, Usage: ``},
+				cli.StringFlag{Name: `creator-gravatar-id`, Usage: ``},
+				cli.BoolFlag{Name: `creator-hireable`, Usage: ``},
+				cli.IntFlag{Name: `creator-id`, Usage: ``},
+				cli.StringFlag{Name: `creator-location`, Usage: ``},
 				cli.StringFlag{Name: `creator-login`, Usage: ``},
-				cli.StringFlag{Name: `creator-company`, Usage: ``},
-				cli.IntFlag{Name: `creator-private-gists`, Usage: ``},
 				cli.StringFlag{Name: `creator-name`, Usage: ``},
-				cli.IntFlag{Name: `creator-public-gists`, Usage: ``},
-				cli.IntFlag{Name: `creator-followers`, Usage: ``},
-				cli.IntFlag{Name: `creator-total-private-repos`, Usage: ``},
 				cli.IntFlag{Name: `creator-owned-private-repos`, Usage: ``},
-				cli.IntFlag{Name: `creator-collaborators`, Usage: ``},
-				cli.StringFlag{Name: `creator-plan-name`, Usage: ``},
-				cli.IntFlag{Name: `creator-plan-space`, Usage: ``},
 				cli.IntFlag{Name: `creator-plan-collaborators`, Usage: ``},
+				cli.StringFlag{Name: `creator-plan-name`, Usage: ``},
 				cli.IntFlag{Name: `creator-plan-private-repos`, Usage: ``},
-				cli.StringFlag{Name: `creator-gravatar-id`, Usage: ``},
-				cli.StringFlag{Name: `creator-blog`, Usage: ``},
-				cli.StringFlag{Name: `creator-created-at`, Usage: ``},
-				cli.IntFlag{Name: `creator-id`, Usage: ``},
+				cli.IntFlag{Name: `creator-plan-space`, Usage: ``},
+				cli.IntFlag{Name: `creator-private-gists`, Usage: ``},
+				cli.IntFlag{Name: `creator-public-gists`, Usage: ``},
 				cli.IntFlag{Name: `creator-public-repos`, Usage: ``},
-				cli.StringFlag{Name: `creator-updated-at`, Usage: ``},
-				cli.StringFlag{Name: `creator-bio`, Usage: ``},
 				cli.BoolFlag{Name: `creator-site-admin`, Usage: ``},
-				cli.StringFlag{Name: `creator-location`, Usage: ``},
-				cli.IntFlag{Name: `creator-disk-usage`, Usage: ``},
-				cli.BoolFlag{Name: `creator-hireable`, Usage: ``},
-				cli.StringFlag{Name: `created-at`, Usage: ``},
+				cli.IntFlag{Name: `creator-total-private-repos`, Usage: ``},
+				cli.StringFlag{Name: `creator-type`, Usage: ``},
+				cli.StringFlag{Name: `creator-updated-at`, Usage: ``},
+				cli.StringFlag{Name: `description`, Usage: `Description is a short high level summary of the status.`},
+				cli.IntFlag{Name: `id`, Usage: ``},
+				cli.StringFlag{Name: `state`, Usage: `State is the current state of the repository.  Possible values are:
+pending, success, error, or failure.`},
+				cli.StringFlag{Name: `updated-at`, Usage: ``},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the RepoStatus, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 3 {
-					showHelp(c, "create-status", "create-status <owner> <repo> <ref>")
+				args := repoArgs(c, 1)
+				if len(args) < 3 {
+					showHelp(c, "create-status", "create-status [<owner> <repo>] <ref>")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				ref := c.Args().Get(2)
-				status := &github.RepoStatus{
-					ID:          github.Int(c.Int("id")),
-					State:       github.String(c.String("state")),
-					Description: github.String(c.String("description")),
-					CreatedAt:   timePointer(now.MustParse(c.String("created-at"))),
-					Context:     github.String(c.String("context")),
-					UpdatedAt:   timePointer(now.MustParse(c.String("updated-at"))),
+				owner := args.Get(0)
+				repo := args.Get(1)
+				ref := args.Get(2)
+				status := &github.RepoStatus{}
+				check(decodeFile(c.String("body-file"), status))
+				if c.IsSet("context") {
+					status.Context = github.String(c.String("context"))
+				}
+				if c.IsSet("created-at") {
+					status.CreatedAt = timePointer(timeFlag(c, "created-at"))
+				}
+				if c.IsSet("description") {
+					status.Description = github.String(c.String("description"))
+				}
+				if c.IsSet("id") {
+					status.ID = github.Int(c.Int("id"))
+				}
+				if c.IsSet("state") {
+					status.State = github.String(c.String("state"))
+				}
+				if c.IsSet("updated-at") {
+					status.UpdatedAt = timePointer(timeFlag(c, "updated-at"))
 				}
 
 				result, res, err := app.gh.Repositories.CreateStatus(owner, repo, ref, status)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "RepoStatus", result)
 
 			},
 		}, cli.Command{
@@ -2544,23 +3043,30 @@ pending, success, error, or failure.`},
 				cli.IntFlag{Name: `page`, Usage: `For paginated result sets, page of results to retrieve.`},
 				cli.IntFlag{Name: `per-page`, Usage: `For paginated result sets, the number of results to include per page.`},
 				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
+				cli.IntFlag{Name: `limit`, Usage: `Stop after this many items, fetching the following pages as needed`},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the ListOptions, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 3 {
-					showHelp(c, "get-combined-status", "get-combined-status <owner> <repo> <ref>")
+				args := repoArgs(c, 1)
+				if len(args) < 3 {
+					showHelp(c, "get-combined-status", "get-combined-status [<owner> <repo>] <ref>")
 				}
 
-				owner := c.Args().Get(0)
-				repo := c.Args().Get(1)
-				ref := c.Args().Get(2)
-				opt := &github.ListOptions{
-					Page:    c.Int("page"),
-					PerPage: c.Int("per-page"),
+				owner := args.Get(0)
+				repo := args.Get(1)
+				ref := args.Get(2)
+				opt := &github.ListOptions{}
+				check(decodeFile(c.String("body-file"), opt))
+				if c.IsSet("page") {
+					opt.Page = c.Int("page")
+				}
+				if c.IsSet("per-page") {
+					opt.PerPage = c.Int("per-page")
 				}
 
 				result, res, err := app.gh.Repositories.GetCombinedStatus(owner, repo, ref, opt)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "CombinedStatus", result)
 
 			},
 		},
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"os"
+	"time"
+)
+
+// Bounds of the exponential backoff between retries
+const (
+	minBackoff = 500 * time.Millisecond
+	maxBackoff = 30 * time.Second
+)
+
+// retryTransport retries requests which failed because of a network error or
+// a 5xx response that may be transient, with jittered exponential backoff.
+// Only idempotent methods are retried, unless retryAll is set. sleep waits
+// between attempts, and retrying stops once the context of the request is done.
+type retryTransport struct {
+	base     http.RoundTripper
+	retries  int
+	retryAll bool
+	sleep    func(context.Context, time.Duration) error
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.retries <= 0 || !(t.retryAll || isIdempotent(req.Method)) {
+		return t.base.RoundTrip(req)
+	}
+
+	body, err := readBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	for attempt := 0; ; attempt++ {
+		res, err := t.base.RoundTrip(withBody(req, body))
+		if attempt >= t.retries || !isTransient(res, err) || req.Context().Err() != nil {
+			return res, err
+		}
+
+		delay := backoff(attempt)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s %s failed: %v, retrying in %s\n", req.Method, req.URL.Path, err, delay)
+		} else {
+			fmt.Fprintf(os.Stderr, "%s %s failed: %s, retrying in %s\n", req.Method, req.URL.Path, res.Status, delay)
+			res.Body.Close()
+		}
+		if err := t.sleep(req.Context(), delay); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case "GET", "HEAD", "OPTIONS", "PUT", "DELETE":
+		return true
+	default:
+		return false
+	}
+}
+
+// isTransient tells whether a failure is worth retrying: the request didn't
+// get a response, or the server or a proxy in front of it had a hiccup.
+func isTransient(res *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	switch res.StatusCode {
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+// backoff doubles the delay on each attempt, and picks a random delay in the
+// upper half so that concurrent clients don't retry in lockstep.
+func backoff(attempt int) time.Duration {
+	d := minBackoff << uint(attempt)
+	if d > maxBackoff || d <= 0 {
+		d = maxBackoff
+	}
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)))
+}
@@ -0,0 +1,125 @@
+package main
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// scriptedServer answers with the given statuses in order, then 200, and
+// records the bodies of the requests it got.
+type scriptedServer struct {
+	*httptest.Server
+	statuses []int
+	bodies   []string
+}
+
+func newScriptedServer(statuses ...int) *scriptedServer {
+	s := &scriptedServer{statuses: statuses}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		s.bodies = append(s.bodies, string(body))
+
+		status := http.StatusOK
+		if len(s.statuses) > 0 {
+			status, s.statuses = s.statuses[0], s.statuses[1:]
+		}
+		w.WriteHeader(status)
+	}))
+	return s
+}
+
+func TestRetryTransport(t *testing.T) {
+	tests := []struct {
+		name     string
+		method   string
+		body     string
+		retries  int
+		retryAll bool
+		statuses []int
+		status   int
+		requests int
+	}{
+		{"transient errors", "GET", "", 3, false, []int{502, 503}, 200, 3},
+		{"client error", "GET", "", 3, false, []int{404}, 404, 1},
+		{"no retries", "GET", "", 0, false, []int{502}, 502, 1},
+		{"retries exhausted", "GET", "", 2, false, []int{503, 502, 503, 502}, 503, 3},
+		{"post", "POST", "payload", 3, false, []int{502}, 502, 1},
+		{"post with retryAll", "POST", "payload", 3, true, []int{502, 503}, 200, 3},
+		{"put", "PUT", "payload", 3, false, []int{500}, 200, 2},
+	}
+
+	for _, test := range tests {
+		srv := newScriptedServer(test.statuses...)
+
+		var sleeps []time.Duration
+		transport := &retryTransport{
+			base:     http.DefaultTransport,
+			retries:  test.retries,
+			retryAll: test.retryAll,
+			sleep: func(ctx context.Context, d time.Duration) error {
+				sleeps = append(sleeps, d)
+				return nil
+			},
+		}
+		req, _ := http.NewRequest(test.method, srv.URL, strings.NewReader(test.body))
+		res, err := transport.RoundTrip(req)
+		srv.Close()
+		if err != nil {
+			t.Errorf("%s: %v", test.name, err)
+			continue
+		}
+		res.Body.Close()
+
+		if res.StatusCode != test.status {
+			t.Errorf("%s: status = %d, want %d", test.name, res.StatusCode, test.status)
+		}
+		if len(srv.bodies) != test.requests {
+			t.Errorf("%s: %d requests, want %d", test.name, len(srv.bodies), test.requests)
+		}
+		if len(sleeps) != len(srv.bodies)-1 {
+			t.Errorf("%s: slept %d times for %d requests", test.name, len(sleeps), len(srv.bodies))
+		}
+		for i, body := range srv.bodies {
+			if body != test.body {
+				t.Errorf("%s: body of request %d = %q, want %q", test.name, i+1, body, test.body)
+			}
+		}
+	}
+}
+
+// TestRetryTimeout checks that a client timeout covers the retries, instead of
+// making each of them fail at once after a full backoff.
+func TestRetryTimeout(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	client := &http.Client{
+		Timeout:   100 * time.Millisecond,
+		Transport: &retryTransport{base: http.DefaultTransport, retries: 5, sleep: sleepContext},
+	}
+	start := time.Now()
+	_, err := client.Get(srv.URL)
+	if err == nil {
+		t.Fatal("got no error")
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("returned after %s, want about the 100ms timeout", elapsed)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	for attempt := 0; attempt < 100; attempt++ {
+		d := backoff(attempt)
+		if d < minBackoff/2 || d > maxBackoff {
+			t.Errorf("backoff(%d) = %s, want between %s and %s", attempt, d, minBackoff/2, maxBackoff)
+		}
+	}
+}
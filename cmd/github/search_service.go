@@ -1,11 +1,8 @@
 package main
 
 import (
-	"fmt"
-
 	"github.com/codegangsta/cli"
 	"github.com/google/go-github/github"
-	"github.com/kr/pretty"
 )
 
 var SearchService = cli.Command{
@@ -20,10 +17,12 @@ var SearchService = cli.Command{
 
    GitHub API docs: http://developer.github.com/v3/search/#search-repositories`,
 			Flags: []cli.Flag{
-				cli.BoolFlag{Name: `text-match`, Usage: `Whether to retrieve text match metadata with a query`},
+				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
+				cli.IntFlag{Name: `limit`, Usage: `Stop after this many items, fetching the following pages as needed`},
+				cli.StringFlag{Name: `order`, Usage: `Sort order if sort parameter is provided. Possible values are: asc,
+desc. Default is desc.`},
 				cli.IntFlag{Name: `page`, Usage: `For paginated result sets, page of results to retrieve.`},
 				cli.IntFlag{Name: `per-page`, Usage: `For paginated result sets, the number of results to include per page.`},
-				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
 				cli.StringFlag{Name: `sort`, Usage: `How to sort the search results.  Possible values are:
   - for repositories: stars, fork, updated
   - for code: indexed
@@ -31,25 +30,43 @@ var SearchService = cli.Command{
   - for users: followers, repositories, joined
 
 Default is to sort by best match.`},
-				cli.StringFlag{Name: `order`, Usage: `Sort order if sort parameter is provided. Possible values are: asc,
-desc. Default is desc.`},
+				cli.BoolFlag{Name: `text-match`, Usage: `Whether to retrieve text match metadata with a query`},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the SearchOptions, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 1 {
+				args := c.Args()
+				if len(args) < 1 {
 					showHelp(c, "repositories", "repositories <query>")
 				}
 
-				query := c.Args().Get(0)
-				opt := &github.SearchOptions{
-					Sort:      c.String("sort"),
-					Order:     c.String("order"),
-					TextMatch: c.Bool("text-match"),
+				query := args.Get(0)
+				opt := &github.SearchOptions{}
+				check(decodeFile(c.String("body-file"), opt))
+				if c.IsSet("order") {
+					opt.Order = c.String("order")
+				}
+				if c.IsSet("sort") {
+					opt.Sort = c.String("sort")
+				}
+				if c.IsSet("text-match") {
+					opt.TextMatch = c.Bool("text-match")
+				}
+				if c.IsSet("page") {
+					opt.Page = c.Int("page")
+				}
+				if c.IsSet("per-page") {
+					opt.PerPage = c.Int("per-page")
 				}
 
-				result, res, err := app.gh.Search.Repositories(query, opt)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				if opt.PerPage == 0 {
+					opt.PerPage = app.profile.PerPage
+				}
 
+				paginateSearch(c, "Repository", "Repositories", func(page int) (interface{}, *github.Response, error) {
+					opt := *opt
+					opt.Page = page
+					return app.gh.Search.Repositories(query, &opt)
+				})
 			},
 		}, cli.Command{
 			Name:  "issues",
@@ -58,6 +75,12 @@ desc. Default is desc.`},
 
    GitHub API docs: http://developer.github.com/v3/search/#search-issues`,
 			Flags: []cli.Flag{
+				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
+				cli.IntFlag{Name: `limit`, Usage: `Stop after this many items, fetching the following pages as needed`},
+				cli.StringFlag{Name: `order`, Usage: `Sort order if sort parameter is provided. Possible values are: asc,
+desc. Default is desc.`},
+				cli.IntFlag{Name: `page`, Usage: `For paginated result sets, page of results to retrieve.`},
+				cli.IntFlag{Name: `per-page`, Usage: `For paginated result sets, the number of results to include per page.`},
 				cli.StringFlag{Name: `sort`, Usage: `How to sort the search results.  Possible values are:
   - for repositories: stars, fork, updated
   - for code: indexed
@@ -65,29 +88,43 @@ desc. Default is desc.`},
   - for users: followers, repositories, joined
 
 Default is to sort by best match.`},
-				cli.StringFlag{Name: `order`, Usage: `Sort order if sort parameter is provided. Possible values are: asc,
-desc. Default is desc.`},
 				cli.BoolFlag{Name: `text-match`, Usage: `Whether to retrieve text match metadata with a query`},
-				cli.IntFlag{Name: `page`, Usage: `For paginated result sets, page of results to retrieve.`},
-				cli.IntFlag{Name: `per-page`, Usage: `For paginated result sets, the number of results to include per page.`},
-				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the SearchOptions, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 1 {
+				args := c.Args()
+				if len(args) < 1 {
 					showHelp(c, "issues", "issues <query>")
 				}
 
-				query := c.Args().Get(0)
-				opt := &github.SearchOptions{
-					Sort:      c.String("sort"),
-					Order:     c.String("order"),
-					TextMatch: c.Bool("text-match"),
+				query := args.Get(0)
+				opt := &github.SearchOptions{}
+				check(decodeFile(c.String("body-file"), opt))
+				if c.IsSet("order") {
+					opt.Order = c.String("order")
+				}
+				if c.IsSet("sort") {
+					opt.Sort = c.String("sort")
+				}
+				if c.IsSet("text-match") {
+					opt.TextMatch = c.Bool("text-match")
+				}
+				if c.IsSet("page") {
+					opt.Page = c.Int("page")
+				}
+				if c.IsSet("per-page") {
+					opt.PerPage = c.Int("per-page")
 				}
 
-				result, res, err := app.gh.Search.Issues(query, opt)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				if opt.PerPage == 0 {
+					opt.PerPage = app.profile.PerPage
+				}
 
+				paginateSearch(c, "Issue", "Issues", func(page int) (interface{}, *github.Response, error) {
+					opt := *opt
+					opt.Page = page
+					return app.gh.Search.Issues(query, &opt)
+				})
 			},
 		}, cli.Command{
 			Name:  "users",
@@ -96,12 +133,12 @@ desc. Default is desc.`},
 
    GitHub API docs: http://developer.github.com/v3/search/#search-users`,
 			Flags: []cli.Flag{
+				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
+				cli.IntFlag{Name: `limit`, Usage: `Stop after this many items, fetching the following pages as needed`},
 				cli.StringFlag{Name: `order`, Usage: `Sort order if sort parameter is provided. Possible values are: asc,
 desc. Default is desc.`},
-				cli.BoolFlag{Name: `text-match`, Usage: `Whether to retrieve text match metadata with a query`},
 				cli.IntFlag{Name: `page`, Usage: `For paginated result sets, page of results to retrieve.`},
 				cli.IntFlag{Name: `per-page`, Usage: `For paginated result sets, the number of results to include per page.`},
-				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
 				cli.StringFlag{Name: `sort`, Usage: `How to sort the search results.  Possible values are:
   - for repositories: stars, fork, updated
   - for code: indexed
@@ -109,23 +146,43 @@ desc. Default is desc.`},
   - for users: followers, repositories, joined
 
 Default is to sort by best match.`},
+				cli.BoolFlag{Name: `text-match`, Usage: `Whether to retrieve text match metadata with a query`},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the SearchOptions, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 1 {
+				args := c.Args()
+				if len(args) < 1 {
 					showHelp(c, "users", "users <query>")
 				}
 
-				query := c.Args().Get(0)
-				opt := &github.SearchOptions{
-					Sort:      c.String("sort"),
-					Order:     c.String("order"),
-					TextMatch: c.Bool("text-match"),
+				query := args.Get(0)
+				opt := &github.SearchOptions{}
+				check(decodeFile(c.String("body-file"), opt))
+				if c.IsSet("order") {
+					opt.Order = c.String("order")
+				}
+				if c.IsSet("sort") {
+					opt.Sort = c.String("sort")
+				}
+				if c.IsSet("text-match") {
+					opt.TextMatch = c.Bool("text-match")
+				}
+				if c.IsSet("page") {
+					opt.Page = c.Int("page")
+				}
+				if c.IsSet("per-page") {
+					opt.PerPage = c.Int("per-page")
 				}
 
-				result, res, err := app.gh.Search.Users(query, opt)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				if opt.PerPage == 0 {
+					opt.PerPage = app.profile.PerPage
+				}
 
+				paginateSearch(c, "User", "Users", func(page int) (interface{}, *github.Response, error) {
+					opt := *opt
+					opt.Page = page
+					return app.gh.Search.Users(query, &opt)
+				})
 			},
 		}, cli.Command{
 			Name:  "code",
@@ -134,10 +191,12 @@ Default is to sort by best match.`},
 
    GitHub API docs: http://developer.github.com/v3/search/#search-code`,
 			Flags: []cli.Flag{
-				cli.BoolFlag{Name: `text-match`, Usage: `Whether to retrieve text match metadata with a query`},
+				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
+				cli.IntFlag{Name: `limit`, Usage: `Stop after this many items, fetching the following pages as needed`},
+				cli.StringFlag{Name: `order`, Usage: `Sort order if sort parameter is provided. Possible values are: asc,
+desc. Default is desc.`},
 				cli.IntFlag{Name: `page`, Usage: `For paginated result sets, page of results to retrieve.`},
 				cli.IntFlag{Name: `per-page`, Usage: `For paginated result sets, the number of results to include per page.`},
-				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
 				cli.StringFlag{Name: `sort`, Usage: `How to sort the search results.  Possible values are:
   - for repositories: stars, fork, updated
   - for code: indexed
@@ -145,25 +204,43 @@ Default is to sort by best match.`},
   - for users: followers, repositories, joined
 
 Default is to sort by best match.`},
-				cli.StringFlag{Name: `order`, Usage: `Sort order if sort parameter is provided. Possible values are: asc,
-desc. Default is desc.`},
+				cli.BoolFlag{Name: `text-match`, Usage: `Whether to retrieve text match metadata with a query`},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the SearchOptions, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 1 {
+				args := c.Args()
+				if len(args) < 1 {
 					showHelp(c, "code", "code <query>")
 				}
 
-				query := c.Args().Get(0)
-				opt := &github.SearchOptions{
-					Order:     c.String("order"),
-					TextMatch: c.Bool("text-match"),
-					Sort:      c.String("sort"),
+				query := args.Get(0)
+				opt := &github.SearchOptions{}
+				check(decodeFile(c.String("body-file"), opt))
+				if c.IsSet("order") {
+					opt.Order = c.String("order")
+				}
+				if c.IsSet("sort") {
+					opt.Sort = c.String("sort")
+				}
+				if c.IsSet("text-match") {
+					opt.TextMatch = c.Bool("text-match")
+				}
+				if c.IsSet("page") {
+					opt.Page = c.Int("page")
+				}
+				if c.IsSet("per-page") {
+					opt.PerPage = c.Int("per-page")
 				}
 
-				result, res, err := app.gh.Search.Code(query, opt)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				if opt.PerPage == 0 {
+					opt.PerPage = app.profile.PerPage
+				}
 
+				paginateSearch(c, "CodeResult", "CodeResults", func(page int) (interface{}, *github.Response, error) {
+					opt := *opt
+					opt.Page = page
+					return app.gh.Search.Code(query, &opt)
+				})
 			},
 		},
 	},
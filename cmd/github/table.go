@@ -0,0 +1,175 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/google/go-github/github"
+)
+
+// Columns shown in table output when --fields isn't given, keyed by the name
+// of the go-github type a command returns.
+var defaultColumns = map[string][]string{
+	"Issue":       {"number", "title", "state", "user.login"},
+	"PullRequest": {"number", "title", "state", "user.login"},
+	"Repository":  {"full_name", "description", "private", "fork"},
+	"Gist":        {"id", "description", "public", "owner.login"},
+	"User":        {"login", "id", "type", "site_admin"},
+	"Team":        {"id", "name", "slug", "permission"},
+
+	"RepositoryContent": {"type", "size", "path"},
+}
+
+// renderTable writes v, a struct or a slice of structs, as aligned columns.
+// Each field is a dot separated path of json names, e.g. "user.login".
+func renderTable(w io.Writer, typeName string, v interface{}, fields []string) error {
+	rows := reflect.Indirect(reflect.ValueOf(v))
+	if !rows.IsValid() {
+		return nil
+	}
+	if rows.Kind() != reflect.Slice {
+		rows = reflect.Append(reflect.MakeSlice(reflect.SliceOf(rows.Type()), 0, 1), rows)
+	}
+
+	if len(fields) == 0 {
+		fields = defaultColumns[typeName]
+	}
+	if len(fields) == 0 {
+		fields = scalarFields(rows.Type().Elem())
+	}
+	if len(fields) == 0 {
+		return fmt.Errorf("no columns to show for %s, use --fields", typeName)
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+
+	var header []string
+	for _, field := range fields {
+		header = append(header, strings.ToUpper(field))
+	}
+	fmt.Fprintln(tw, strings.Join(header, "\t"))
+
+	for i := 0; i < rows.Len(); i++ {
+		var cells []string
+		for _, field := range fields {
+			cell, err := lookupField(rows.Index(i), field)
+			if err != nil {
+				return err
+			}
+			cells = append(cells, cell)
+		}
+		fmt.Fprintln(tw, strings.Join(cells, "\t"))
+	}
+
+	return tw.Flush()
+}
+
+// lookupField walks path through nested structs and formats the value found
+// at the end of it. Nil pointers along the way yield an empty cell.
+func lookupField(v reflect.Value, path string) (string, error) {
+	for _, name := range strings.Split(path, ".") {
+		v = reflect.Indirect(v)
+		if !v.IsValid() {
+			return "", nil
+		}
+		if v.Kind() != reflect.Struct {
+			return "", fmt.Errorf("can't select %q in %s: not a struct", name, path)
+		}
+
+		i := fieldIndex(v.Type(), name)
+		if i < 0 {
+			return "", fmt.Errorf("unknown field %q in %s", name, path)
+		}
+		v = v.Field(i)
+	}
+
+	return formatCell(v), nil
+}
+
+// fieldIndex matches name against json tag names first, then against Go field
+// names regardless of case, so both "full_name" and "fullname" work.
+func fieldIndex(t reflect.Type, name string) int {
+	for i := 0; i < t.NumField(); i++ {
+		if jsonName(t.Field(i)) == name {
+			return i
+		}
+	}
+	for i := 0; i < t.NumField(); i++ {
+		if strings.EqualFold(t.Field(i).Name, name) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func jsonName(f reflect.StructField) string {
+	tag := strings.Split(f.Tag.Get("json"), ",")[0]
+	if tag == "" || tag == "-" {
+		return ""
+	}
+	return tag
+}
+
+func formatCell(v reflect.Value) string {
+	v = reflect.Indirect(v)
+	if !v.IsValid() {
+		return ""
+	}
+
+	switch value := v.Interface().(type) {
+	case time.Time:
+		return value.Format(time.RFC3339)
+	case github.Timestamp:
+		return value.Format(time.RFC3339)
+	}
+
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array:
+		var items []string
+		for i := 0; i < v.Len(); i++ {
+			items = append(items, formatCell(v.Index(i)))
+		}
+		return strings.Join(items, ",")
+	case reflect.String:
+		// Keep multi-line values such as bodies on a single row
+		return strings.Replace(v.String(), "\n", " ", -1)
+	default:
+		return fmt.Sprint(v.Interface())
+	}
+}
+
+// scalarFields lists the top level fields of t that fit in a single cell, used
+// for types without default columns.
+func scalarFields(t reflect.Type) []string {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+
+	var fields []string
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name := jsonName(f)
+		if name == "" || strings.HasSuffix(name, "url") {
+			continue
+		}
+
+		ft := f.Type
+		if ft.Kind() == reflect.Ptr {
+			ft = ft.Elem()
+		}
+		switch ft.Kind() {
+		case reflect.String, reflect.Bool, reflect.Int, reflect.Int64, reflect.Float64:
+			fields = append(fields, name)
+		}
+	}
+
+	return fields
+}
@@ -0,0 +1,131 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/google/go-github/github"
+)
+
+var colors = map[string]string{
+	"red":     "31",
+	"green":   "32",
+	"yellow":  "33",
+	"blue":    "34",
+	"magenta": "35",
+	"cyan":    "36",
+	"bold":    "1",
+}
+
+// Helpers available to --template, on top of the text/template builtins.
+// Fields of go-github types are mostly pointers, so helpers take interface{}
+// and dereference as needed.
+var templateFuncs = template.FuncMap{
+	"json": func(v interface{}) (string, error) {
+		out, err := json.Marshal(v)
+		return string(out), err
+	},
+	"join": func(sep string, v interface{}) string {
+		items := reflect.Indirect(reflect.ValueOf(v))
+		if items.Kind() != reflect.Slice && items.Kind() != reflect.Array {
+			return formatCell(items)
+		}
+
+		var strs []string
+		for i := 0; i < items.Len(); i++ {
+			strs = append(strs, formatCell(items.Index(i)))
+		}
+		return strings.Join(strs, sep)
+	},
+	"truncate": func(length int, v interface{}) string {
+		s := []rune(formatCell(reflect.ValueOf(v)))
+		if len(s) <= length {
+			return string(s)
+		}
+		return string(s[:length])
+	},
+	"timeago": func(v interface{}) string {
+		var t time.Time
+		switch value := v.(type) {
+		case time.Time:
+			t = value
+		case *time.Time:
+			if value == nil {
+				return ""
+			}
+			t = *value
+		case github.Timestamp:
+			t = value.Time
+		case *github.Timestamp:
+			if value == nil {
+				return ""
+			}
+			t = value.Time
+		default:
+			return fmt.Sprint(v)
+		}
+		return timeAgo(time.Since(t))
+	},
+	"color": func(name string, v interface{}) string {
+		code, ok := colors[name]
+		if !ok {
+			return formatCell(reflect.ValueOf(v))
+		}
+		return "\x1b[" + code + "m" + formatCell(reflect.ValueOf(v)) + "\x1b[0m"
+	},
+}
+
+// renderTemplate executes text once for a single result, or once per item for
+// list results, each followed by a newline.
+func renderTemplate(w io.Writer, text string, v interface{}) error {
+	tmpl, err := parseOutputTemplate(text)
+	if err != nil {
+		return err
+	}
+
+	items := reflect.ValueOf(v)
+	if items.Kind() != reflect.Slice {
+		return executeLine(w, tmpl, v)
+	}
+
+	for i := 0; i < items.Len(); i++ {
+		if err := executeLine(w, tmpl, items.Index(i).Interface()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func parseOutputTemplate(text string) (*template.Template, error) {
+	return template.New("output").Funcs(templateFuncs).Parse(text)
+}
+
+func executeLine(w io.Writer, tmpl *template.Template, v interface{}) error {
+	if err := tmpl.Execute(w, v); err != nil {
+		return err
+	}
+	_, err := fmt.Fprintln(w)
+	return err
+}
+
+func timeAgo(d time.Duration) string {
+	switch {
+	case d < time.Minute:
+		return "just now"
+	case d < time.Hour:
+		return fmt.Sprintf("%d minutes ago", int(d.Minutes()))
+	case d < 24*time.Hour:
+		return fmt.Sprintf("%d hours ago", int(d.Hours()))
+	case d < 30*24*time.Hour:
+		return fmt.Sprintf("%d days ago", int(d.Hours()/24))
+	case d < 365*24*time.Hour:
+		return fmt.Sprintf("%d months ago", int(d.Hours()/24/30))
+	default:
+		return fmt.Sprintf("%d years ago", int(d.Hours()/24/365))
+	}
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/codegangsta/cli"
+	"github.com/jinzhu/now"
+)
+
+var relativeTime = regexp.MustCompile(`^(\d+)([dw])$`)
+
+// parseTime understands RFC3339 timestamps, the keywords now, today and
+// yesterday, and durations relative to now such as 7d, 2w or 36h. Anything
+// else goes through jinzhu/now, which accepts most date and time layouts.
+func parseTime(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+
+	switch value {
+	case "now":
+		return time.Now(), nil
+	case "today":
+		return now.BeginningOfDay(), nil
+	case "yesterday":
+		return now.BeginningOfDay().AddDate(0, 0, -1), nil
+	}
+
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+
+	if m := relativeTime.FindStringSubmatch(value); m != nil {
+		n, _ := strconv.Atoi(m[1])
+		if m[2] == "w" {
+			n *= 7
+		}
+		return time.Now().AddDate(0, 0, -n), nil
+	}
+	if d, err := time.ParseDuration(value); err == nil && d > 0 {
+		return time.Now().Add(-d), nil
+	}
+
+	if t, err := now.Parse(value); err == nil {
+		return t, nil
+	}
+
+	return time.Time{}, fmt.Errorf("invalid time %q, expected RFC3339, a date, a duration such as 7d, 2w or 36h, or one of now, today, yesterday", value)
+}
+
+// timeFlag returns the time given with the named flag, or the zero time when
+// it is absent. Invalid values abort with a validation error.
+func timeFlag(c *cli.Context, name string) time.Time {
+	value := c.String(name)
+	if value == "" {
+		return time.Time{}
+	}
+
+	t, err := parseTime(value)
+	if err != nil {
+		exit(fmt.Errorf("--%s: %v", name, err))
+	}
+	return t
+}
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jinzhu/now"
+)
+
+func TestParseTime(t *testing.T) {
+	tests := []struct {
+		value string
+		want  time.Time
+	}{
+		{"2015-06-01T12:30:00Z", time.Date(2015, 6, 1, 12, 30, 0, 0, time.UTC)},
+		{" 2015-06-01T12:30:00+02:00 ", time.Date(2015, 6, 1, 10, 30, 0, 0, time.UTC)},
+		{"today", now.BeginningOfDay()},
+		{"yesterday", now.BeginningOfDay().AddDate(0, 0, -1)},
+		{"now", time.Now()},
+		{"7d", time.Now().AddDate(0, 0, -7)},
+		{"2w", time.Now().AddDate(0, 0, -14)},
+		{"36h", time.Now().Add(-36 * time.Hour)},
+		{"2015-06-01", time.Date(2015, 6, 1, 0, 0, 0, 0, time.Local)},
+	}
+
+	for _, test := range tests {
+		got, err := parseTime(test.value)
+		if err != nil {
+			t.Errorf("parseTime(%q): %v", test.value, err)
+			continue
+		}
+		// Relative times depend on when they are computed
+		if d := got.Sub(test.want); d < -time.Minute || d > time.Minute {
+			t.Errorf("parseTime(%q) = %s, want %s", test.value, got, test.want)
+		}
+	}
+
+	for _, value := range []string{"", "later", "-3d", "0h"} {
+		if got, err := parseTime(value); err == nil {
+			t.Errorf("parseTime(%q) = %s, want an error", value, got)
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"time"
+)
+
+// readBody consumes and returns the body of req, so that transports which
+// send a request several times can replay it with withBody.
+func readBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil {
+		return nil, nil
+	}
+	defer req.Body.Close()
+	return ioutil.ReadAll(req.Body)
+}
+
+// withBody returns a shallow copy of req reading from body. RoundTrippers must
+// not modify the request they're given.
+func withBody(req *http.Request, body []byte) *http.Request {
+	r := *req
+	if body != nil {
+		r.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+	return &r
+}
+
+// sleepContext waits for d, unless ctx is done first, e.g. once --timeout is
+// reached. It then returns the error of ctx.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -1,13 +1,10 @@
 package main
 
 import (
-	"fmt"
 	"strconv"
 
 	"github.com/codegangsta/cli"
 	"github.com/google/go-github/github"
-	"github.com/jinzhu/now"
-	"github.com/kr/pretty"
 )
 
 var UsersService = cli.Command{
@@ -24,15 +21,16 @@ var UsersService = cli.Command{
    GitHub API docs: http://developer.github.com/v3/users/#get-a-single-user`,
 			Flags: []cli.Flag{},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 1 {
+				args := c.Args()
+				if len(args) < 1 {
 					showHelp(c, "get", "get <user>")
 				}
 
-				user := c.Args().Get(0)
+				user := args.Get(0)
 
 				result, res, err := app.gh.Users.Get(user)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "User", result)
 
 			},
 		}, cli.Command{
@@ -42,64 +40,111 @@ var UsersService = cli.Command{
 
    GitHub API docs: http://developer.github.com/v3/users/#update-the-authenticated-user`,
 			Flags: []cli.Flag{
-				cli.BoolFlag{Name: `hireable`, Usage: ``},
+				cli.StringFlag{Name: `bio`, Usage: ``},
+				cli.StringFlag{Name: `blog`, Usage: ``},
+				cli.IntFlag{Name: `collaborators`, Usage: ``},
 				cli.StringFlag{Name: `company`, Usage: ``},
+				cli.StringFlag{Name: `created-at`, Usage: ``},
+				cli.IntFlag{Name: `disk-usage`, Usage: ``},
 				cli.StringFlag{Name: `email`, Usage: ``},
+				cli.IntFlag{Name: `followers`, Usage: ``},
 				cli.IntFlag{Name: `following`, Usage: ``},
-				cli.StringFlag{Name: `type`, Usage: ``},
+				cli.StringFlag{Name: `gravatar-id`, Usage: ``},
+				cli.BoolFlag{Name: `hireable`, Usage: ``},
+				cli.IntFlag{Name: `id`, Usage: ``},
+				cli.StringFlag{Name: `location`, Usage: ``},
 				cli.StringFlag{Name: `login`, Usage: ``},
-				cli.IntFlag{Name: `public-gists`, Usage: ``},
-				cli.IntFlag{Name: `followers`, Usage: ``},
-				cli.IntFlag{Name: `private-gists`, Usage: ``},
 				cli.StringFlag{Name: `name`, Usage: ``},
-				cli.StringFlag{Name: `blog`, Usage: ``},
-				cli.StringFlag{Name: `created-at`, Usage: ``},
-				cli.IntFlag{Name: `total-private-repos`, Usage: ``},
 				cli.IntFlag{Name: `owned-private-repos`, Usage: ``},
-				cli.IntFlag{Name: `collaborators`, Usage: ``},
-				cli.StringFlag{Name: `plan-name`, Usage: ``},
-				cli.IntFlag{Name: `plan-space`, Usage: ``},
 				cli.IntFlag{Name: `plan-collaborators`, Usage: ``},
+				cli.StringFlag{Name: `plan-name`, Usage: ``},
 				cli.IntFlag{Name: `plan-private-repos`, Usage: ``},
-				cli.StringFlag{Name: `gravatar-id`, Usage: ``},
+				cli.IntFlag{Name: `plan-space`, Usage: ``},
+				cli.IntFlag{Name: `private-gists`, Usage: ``},
+				cli.IntFlag{Name: `public-gists`, Usage: ``},
 				cli.IntFlag{Name: `public-repos`, Usage: ``},
-				cli.StringFlag{Name: `updated-at`, Usage: ``},
-				cli.IntFlag{Name: `id`, Usage: ``},
 				cli.BoolFlag{Name: `site-admin`, Usage: ``},
-				cli.StringFlag{Name: `bio`, Usage: ``},
-				cli.StringFlag{Name: `location`, Usage: ``},
-				cli.IntFlag{Name: `disk-usage`, Usage: ``},
+				cli.IntFlag{Name: `total-private-repos`, Usage: ``},
+				cli.StringFlag{Name: `type`, Usage: ``},
+				cli.StringFlag{Name: `updated-at`, Usage: ``},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the User, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				user := &github.User{
-					Location:          github.String(c.String("location")),
-					DiskUsage:         github.Int(c.Int("disk-usage")),
-					Hireable:          github.Bool(c.Bool("hireable")),
-					Following:         github.Int(c.Int("following")),
-					Type:              github.String(c.String("type")),
-					Login:             github.String(c.String("login")),
-					Company:           github.String(c.String("company")),
-					Email:             github.String(c.String("email")),
-					Name:              github.String(c.String("name")),
-					PublicGists:       github.Int(c.Int("public-gists")),
-					Followers:         github.Int(c.Int("followers")),
-					PrivateGists:      github.Int(c.Int("private-gists")),
-					OwnedPrivateRepos: github.Int(c.Int("owned-private-repos")),
-					Collaborators:     github.Int(c.Int("collaborators")),
-					GravatarID:        github.String(c.String("gravatar-id")),
-					Blog:              github.String(c.String("blog")),
-					CreatedAt:         &github.Timestamp{now.MustParse(c.String("created-at"))},
-					TotalPrivateRepos: github.Int(c.Int("total-private-repos")),
-					ID:                github.Int(c.Int("id")),
-					PublicRepos:       github.Int(c.Int("public-repos")),
-					UpdatedAt:         &github.Timestamp{now.MustParse(c.String("updated-at"))},
-					Bio:               github.String(c.String("bio")),
-					SiteAdmin:         github.Bool(c.Bool("site-admin")),
+				user := &github.User{}
+				check(decodeFile(c.String("body-file"), user))
+				if c.IsSet("bio") {
+					user.Bio = github.String(c.String("bio"))
+				}
+				if c.IsSet("blog") {
+					user.Blog = github.String(c.String("blog"))
+				}
+				if c.IsSet("collaborators") {
+					user.Collaborators = github.Int(c.Int("collaborators"))
+				}
+				if c.IsSet("company") {
+					user.Company = github.String(c.String("company"))
+				}
+				if c.IsSet("created-at") {
+					user.CreatedAt = &github.Timestamp{Time: timeFlag(c, "created-at")}
+				}
+				if c.IsSet("disk-usage") {
+					user.DiskUsage = github.Int(c.Int("disk-usage"))
+				}
+				if c.IsSet("email") {
+					user.Email = github.String(c.String("email"))
+				}
+				if c.IsSet("followers") {
+					user.Followers = github.Int(c.Int("followers"))
+				}
+				if c.IsSet("following") {
+					user.Following = github.Int(c.Int("following"))
+				}
+				if c.IsSet("gravatar-id") {
+					user.GravatarID = github.String(c.String("gravatar-id"))
+				}
+				if c.IsSet("hireable") {
+					user.Hireable = github.Bool(c.Bool("hireable"))
+				}
+				if c.IsSet("id") {
+					user.ID = github.Int(c.Int("id"))
+				}
+				if c.IsSet("location") {
+					user.Location = github.String(c.String("location"))
+				}
+				if c.IsSet("login") {
+					user.Login = github.String(c.String("login"))
+				}
+				if c.IsSet("name") {
+					user.Name = github.String(c.String("name"))
+				}
+				if c.IsSet("owned-private-repos") {
+					user.OwnedPrivateRepos = github.Int(c.Int("owned-private-repos"))
+				}
+				if c.IsSet("private-gists") {
+					user.PrivateGists = github.Int(c.Int("private-gists"))
+				}
+				if c.IsSet("public-gists") {
+					user.PublicGists = github.Int(c.Int("public-gists"))
+				}
+				if c.IsSet("public-repos") {
+					user.PublicRepos = github.Int(c.Int("public-repos"))
+				}
+				if c.IsSet("site-admin") {
+					user.SiteAdmin = github.Bool(c.Bool("site-admin"))
+				}
+				if c.IsSet("total-private-repos") {
+					user.TotalPrivateRepos = github.Int(c.Int("total-private-repos"))
+				}
+				if c.IsSet("type") {
+					user.Type = github.String(c.String("type"))
+				}
+				if c.IsSet("updated-at") {
+					user.UpdatedAt = &github.Timestamp{Time: timeFlag(c, "updated-at")}
 				}
 
 				result, res, err := app.gh.Users.Edit(user)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "User", result)
 
 			},
 		}, cli.Command{
@@ -110,15 +155,18 @@ var UsersService = cli.Command{
    GitHub API docs: http://developer.github.com/v3/users/#get-all-users`,
 			Flags: []cli.Flag{
 				cli.IntFlag{Name: `since`, Usage: `ID of the last user seen`},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the UserListOptions, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				opt := &github.UserListOptions{
-					Since: c.Int("since"),
+				opt := &github.UserListOptions{}
+				check(decodeFile(c.String("body-file"), opt))
+				if c.IsSet("since") {
+					opt.Since = c.Int("since")
 				}
 
 				result, res, err := app.gh.Users.ListAll(opt)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "User", result)
 
 			},
 		}, cli.Command{
@@ -129,14 +177,15 @@ var UsersService = cli.Command{
    GitHub API docs: https://developer.github.com/v3/users/administration/#promote-an-ordinary-user-to-a-site-administrator`,
 			Flags: []cli.Flag{},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 1 {
+				args := c.Args()
+				if len(args) < 1 {
 					showHelp(c, "promote-site-admin", "promote-site-admin <user>")
 				}
 
-				user := c.Args().Get(0)
+				user := args.Get(0)
 
 				res, err := app.gh.Users.PromoteSiteAdmin(user)
-				checkResponse(res.Response, err)
+				checkResponse(res, err)
 
 			},
 		}, cli.Command{
@@ -145,16 +194,21 @@ var UsersService = cli.Command{
 			Description: `demote-site-admin demotes a user from site administrator of a GitHub Enterprise instance.
 
    GitHub API docs: https://developer.github.com/v3/users/administration/#demote-a-site-administrator-to-an-ordinary-user`,
-			Flags: []cli.Flag{},
+			Flags: []cli.Flag{
+				cli.BoolFlag{Name: `yes`, Usage: `Don't ask for confirmation`},
+			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 1 {
+				args := c.Args()
+				if len(args) < 1 {
 					showHelp(c, "demote-site-admin", "demote-site-admin <user>")
 				}
 
-				user := c.Args().Get(0)
+				user := args.Get(0)
+
+				confirm(c, "demote-site-admin", user)
 
 				res, err := app.gh.Users.DemoteSiteAdmin(user)
-				checkResponse(res.Response, err)
+				checkResponse(res, err)
 
 			},
 		}, cli.Command{
@@ -163,16 +217,21 @@ var UsersService = cli.Command{
 			Description: `suspend a user on a GitHub Enterprise instance.
 
    GitHub API docs: https://developer.github.com/v3/users/administration/#suspend-a-user`,
-			Flags: []cli.Flag{},
+			Flags: []cli.Flag{
+				cli.BoolFlag{Name: `yes`, Usage: `Don't ask for confirmation`},
+			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 1 {
+				args := c.Args()
+				if len(args) < 1 {
 					showHelp(c, "suspend", "suspend <user>")
 				}
 
-				user := c.Args().Get(0)
+				user := args.Get(0)
+
+				confirm(c, "suspend", user)
 
 				res, err := app.gh.Users.Suspend(user)
-				checkResponse(res.Response, err)
+				checkResponse(res, err)
 
 			},
 		}, cli.Command{
@@ -183,14 +242,15 @@ var UsersService = cli.Command{
    GitHub API docs: https://developer.github.com/v3/users/administration/#unsuspend-a-user`,
 			Flags: []cli.Flag{},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 1 {
+				args := c.Args()
+				if len(args) < 1 {
 					showHelp(c, "unsuspend", "unsuspend <user>")
 				}
 
-				user := c.Args().Get(0)
+				user := args.Get(0)
 
 				res, err := app.gh.Users.Unsuspend(user)
-				checkResponse(res.Response, err)
+				checkResponse(res, err)
 
 			},
 		}, cli.Command{
@@ -203,27 +263,28 @@ var UsersService = cli.Command{
 				cli.IntFlag{Name: `page`, Usage: `For paginated result sets, page of results to retrieve.`},
 				cli.IntFlag{Name: `per-page`, Usage: `For paginated result sets, the number of results to include per page.`},
 				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
+				cli.IntFlag{Name: `limit`, Usage: `Stop after this many items, fetching the following pages as needed`},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the ListOptions, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				opt := &github.ListOptions{
-					PerPage: c.Int("per-page"),
-					Page:    c.Int("page"),
+				opt := &github.ListOptions{}
+				check(decodeFile(c.String("body-file"), opt))
+				if c.IsSet("page") {
+					opt.Page = c.Int("page")
+				}
+				if c.IsSet("per-page") {
+					opt.PerPage = c.Int("per-page")
 				}
 
-				var items []github.UserEmail
-
-				for {
-					page, res, err := app.gh.Users.ListEmails(opt)
-					checkResponse(res.Response, err)
-
-					items = append(items, page...)
-					if res.NextPage == 0 || !c.Bool("all") {
-						break
-					}
-					opt.Page = res.NextPage
+				if opt.PerPage == 0 {
+					opt.PerPage = app.profile.PerPage
 				}
 
-				fmt.Printf("%# v", pretty.Formatter(items))
+				paginate(c, "UserEmail", func(page int) (interface{}, *github.Response, error) {
+					opt := *opt
+					opt.Page = page
+					return app.gh.Users.ListEmails(&opt)
+				})
 			},
 		}, cli.Command{
 			Name:  "add-emails",
@@ -238,8 +299,8 @@ var UsersService = cli.Command{
 				emails := c.StringSlice("emails")
 
 				result, res, err := app.gh.Users.AddEmails(emails)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "UserEmail", result)
 
 			},
 		}, cli.Command{
@@ -250,12 +311,15 @@ var UsersService = cli.Command{
    GitHub API docs: http://developer.github.com/v3/users/emails/#delete-email-addresses`,
 			Flags: []cli.Flag{
 				cli.StringSliceFlag{Name: `emails`, Usage: ``},
+				cli.BoolFlag{Name: `yes`, Usage: `Don't ask for confirmation`},
 			},
 			Action: func(c *cli.Context) {
 				emails := c.StringSlice("emails")
 
+				confirm(c, "delete-emails", "delete-emails")
+
 				res, err := app.gh.Users.DeleteEmails(emails)
-				checkResponse(res.Response, err)
+				checkResponse(res, err)
 
 			},
 		}, cli.Command{
@@ -269,32 +333,34 @@ var UsersService = cli.Command{
 				cli.IntFlag{Name: `page`, Usage: `For paginated result sets, page of results to retrieve.`},
 				cli.IntFlag{Name: `per-page`, Usage: `For paginated result sets, the number of results to include per page.`},
 				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
+				cli.IntFlag{Name: `limit`, Usage: `Stop after this many items, fetching the following pages as needed`},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the ListOptions, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 1 {
+				args := c.Args()
+				if len(args) < 1 {
 					showHelp(c, "list-followers", "list-followers <user>")
 				}
 
-				user := c.Args().Get(0)
-				opt := &github.ListOptions{
-					Page:    c.Int("page"),
-					PerPage: c.Int("per-page"),
+				user := args.Get(0)
+				opt := &github.ListOptions{}
+				check(decodeFile(c.String("body-file"), opt))
+				if c.IsSet("page") {
+					opt.Page = c.Int("page")
+				}
+				if c.IsSet("per-page") {
+					opt.PerPage = c.Int("per-page")
 				}
 
-				var items []github.User
-
-				for {
-					page, res, err := app.gh.Users.ListFollowers(user, opt)
-					checkResponse(res.Response, err)
-
-					items = append(items, page...)
-					if res.NextPage == 0 || !c.Bool("all") {
-						break
-					}
-					opt.Page = res.NextPage
+				if opt.PerPage == 0 {
+					opt.PerPage = app.profile.PerPage
 				}
 
-				fmt.Printf("%# v", pretty.Formatter(items))
+				paginate(c, "User", func(page int) (interface{}, *github.Response, error) {
+					opt := *opt
+					opt.Page = page
+					return app.gh.Users.ListFollowers(user, &opt)
+				})
 			},
 		}, cli.Command{
 			Name:  "list-following",
@@ -307,32 +373,34 @@ var UsersService = cli.Command{
 				cli.IntFlag{Name: `page`, Usage: `For paginated result sets, page of results to retrieve.`},
 				cli.IntFlag{Name: `per-page`, Usage: `For paginated result sets, the number of results to include per page.`},
 				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
+				cli.IntFlag{Name: `limit`, Usage: `Stop after this many items, fetching the following pages as needed`},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the ListOptions, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 1 {
+				args := c.Args()
+				if len(args) < 1 {
 					showHelp(c, "list-following", "list-following <user>")
 				}
 
-				user := c.Args().Get(0)
-				opt := &github.ListOptions{
-					Page:    c.Int("page"),
-					PerPage: c.Int("per-page"),
+				user := args.Get(0)
+				opt := &github.ListOptions{}
+				check(decodeFile(c.String("body-file"), opt))
+				if c.IsSet("page") {
+					opt.Page = c.Int("page")
+				}
+				if c.IsSet("per-page") {
+					opt.PerPage = c.Int("per-page")
 				}
 
-				var items []github.User
-
-				for {
-					page, res, err := app.gh.Users.ListFollowing(user, opt)
-					checkResponse(res.Response, err)
-
-					items = append(items, page...)
-					if res.NextPage == 0 || !c.Bool("all") {
-						break
-					}
-					opt.Page = res.NextPage
+				if opt.PerPage == 0 {
+					opt.PerPage = app.profile.PerPage
 				}
 
-				fmt.Printf("%# v", pretty.Formatter(items))
+				paginate(c, "User", func(page int) (interface{}, *github.Response, error) {
+					opt := *opt
+					opt.Page = page
+					return app.gh.Users.ListFollowing(user, &opt)
+				})
 			},
 		}, cli.Command{
 			Name:  "is-following",
@@ -343,16 +411,17 @@ var UsersService = cli.Command{
    GitHub API docs: http://developer.github.com/v3/users/followers/#check-if-you-are-following-a-user`,
 			Flags: []cli.Flag{},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 2 {
+				args := c.Args()
+				if len(args) < 2 {
 					showHelp(c, "is-following", "is-following <user> <target>")
 				}
 
-				user := c.Args().Get(0)
-				target := c.Args().Get(1)
+				user := args.Get(0)
+				target := args.Get(1)
 
 				result, res, err := app.gh.Users.IsFollowing(user, target)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "bool", result)
 
 			},
 		}, cli.Command{
@@ -363,14 +432,15 @@ var UsersService = cli.Command{
    GitHub API docs: http://developer.github.com/v3/users/followers/#follow-a-user`,
 			Flags: []cli.Flag{},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 1 {
+				args := c.Args()
+				if len(args) < 1 {
 					showHelp(c, "follow", "follow <user>")
 				}
 
-				user := c.Args().Get(0)
+				user := args.Get(0)
 
 				res, err := app.gh.Users.Follow(user)
-				checkResponse(res.Response, err)
+				checkResponse(res, err)
 
 			},
 		}, cli.Command{
@@ -381,14 +451,15 @@ var UsersService = cli.Command{
    GitHub API docs: http://developer.github.com/v3/users/followers/#unfollow-a-user`,
 			Flags: []cli.Flag{},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 1 {
+				args := c.Args()
+				if len(args) < 1 {
 					showHelp(c, "unfollow", "unfollow <user>")
 				}
 
-				user := c.Args().Get(0)
+				user := args.Get(0)
 
 				res, err := app.gh.Users.Unfollow(user)
-				checkResponse(res.Response, err)
+				checkResponse(res, err)
 
 			},
 		}, cli.Command{
@@ -402,32 +473,34 @@ var UsersService = cli.Command{
 				cli.IntFlag{Name: `page`, Usage: `For paginated result sets, page of results to retrieve.`},
 				cli.IntFlag{Name: `per-page`, Usage: `For paginated result sets, the number of results to include per page.`},
 				cli.BoolFlag{Name: `all`, Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
+				cli.IntFlag{Name: `limit`, Usage: `Stop after this many items, fetching the following pages as needed`},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the ListOptions, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 1 {
+				args := c.Args()
+				if len(args) < 1 {
 					showHelp(c, "list-keys", "list-keys <user>")
 				}
 
-				user := c.Args().Get(0)
-				opt := &github.ListOptions{
-					PerPage: c.Int("per-page"),
-					Page:    c.Int("page"),
+				user := args.Get(0)
+				opt := &github.ListOptions{}
+				check(decodeFile(c.String("body-file"), opt))
+				if c.IsSet("page") {
+					opt.Page = c.Int("page")
+				}
+				if c.IsSet("per-page") {
+					opt.PerPage = c.Int("per-page")
 				}
 
-				var items []github.Key
-
-				for {
-					page, res, err := app.gh.Users.ListKeys(user, opt)
-					checkResponse(res.Response, err)
-
-					items = append(items, page...)
-					if res.NextPage == 0 || !c.Bool("all") {
-						break
-					}
-					opt.Page = res.NextPage
+				if opt.PerPage == 0 {
+					opt.PerPage = app.profile.PerPage
 				}
 
-				fmt.Printf("%# v", pretty.Formatter(items))
+				paginate(c, "Key", func(page int) (interface{}, *github.Response, error) {
+					opt := *opt
+					opt.Page = page
+					return app.gh.Users.ListKeys(user, &opt)
+				})
 			},
 		}, cli.Command{
 			Name:  "get-key",
@@ -437,16 +510,17 @@ var UsersService = cli.Command{
    GitHub API docs: http://developer.github.com/v3/users/keys/#get-a-single-public-key`,
 			Flags: []cli.Flag{},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 1 {
+				args := c.Args()
+				if len(args) < 1 {
 					showHelp(c, "get-key", "get-key <id>")
 				}
 
-				id, err := strconv.Atoi(c.Args().Get(0))
+				id, err := strconv.Atoi(args.Get(0))
 				check(err)
 
 				result, res, err := app.gh.Users.GetKey(id)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "Key", result)
 
 			},
 		}, cli.Command{
@@ -456,20 +530,27 @@ var UsersService = cli.Command{
 
    GitHub API docs: http://developer.github.com/v3/users/keys/#create-a-public-key`,
 			Flags: []cli.Flag{
-				cli.StringFlag{Name: `title`, Usage: ``},
 				cli.IntFlag{Name: `id`, Usage: ``},
 				cli.StringFlag{Name: `key`, Usage: ``},
+				cli.StringFlag{Name: `title`, Usage: ``},
+				cli.StringFlag{Name: `body-file`, Usage: `JSON or YAML file holding the Key, - to read from stdin. Flags take precedence over the file`},
 			},
 			Action: func(c *cli.Context) {
-				key := &github.Key{
-					ID:    github.Int(c.Int("id")),
-					Key:   github.String(c.String("key")),
-					Title: github.String(c.String("title")),
+				key := &github.Key{}
+				check(decodeFile(c.String("body-file"), key))
+				if c.IsSet("id") {
+					key.ID = github.Int(c.Int("id"))
+				}
+				if c.IsSet("key") {
+					key.Key = github.String(c.String("key"))
+				}
+				if c.IsSet("title") {
+					key.Title = github.String(c.String("title"))
 				}
 
 				result, res, err := app.gh.Users.CreateKey(key)
-				checkResponse(res.Response, err)
-				fmt.Printf("%# v", pretty.Formatter(result))
+				checkResponse(res, err)
+				render(c, "Key", result)
 
 			},
 		}, cli.Command{
@@ -478,17 +559,22 @@ var UsersService = cli.Command{
 			Description: `delete-key deletes a public key.
 
    GitHub API docs: http://developer.github.com/v3/users/keys/#delete-a-public-key`,
-			Flags: []cli.Flag{},
+			Flags: []cli.Flag{
+				cli.BoolFlag{Name: `yes`, Usage: `Don't ask for confirmation`},
+			},
 			Action: func(c *cli.Context) {
-				if len(c.Args()) < 1 {
+				args := c.Args()
+				if len(args) < 1 {
 					showHelp(c, "delete-key", "delete-key <id>")
 				}
 
-				id, err := strconv.Atoi(c.Args().Get(0))
+				id, err := strconv.Atoi(args.Get(0))
 				check(err)
 
+				confirm(c, "delete-key", strconv.Itoa(id))
+
 				res, err := app.gh.Users.DeleteKey(id)
-				checkResponse(res.Response, err)
+				checkResponse(res, err)
 
 			},
 		},
@@ -5,6 +5,8 @@ import (
 	"go/ast"
 	"go/doc"
 	"go/parser"
+	"go/token"
+	"strconv"
 	"strings"
 
 	"golang.org/x/tools/go/loader"
@@ -23,6 +25,7 @@ type method struct {
 	Service     string
 	Name        string
 	Description string
+	Verb        string
 	Args        []argument
 	Returns     []string
 }
@@ -36,8 +39,10 @@ func (m method) String() string {
 	return fmt.Sprintf("%s.%s(%s) (%s)", m.Service, m.Name, strings.Join(strargs, ", "), strings.Join(m.Returns, ", "))
 }
 
+// Usage is the first sentence of the description. Its indentation is removed
+// first, since go/doc takes indented lines for a code block.
 func (m method) Usage() string {
-	return doc.Synopsis(m.Description)
+	return doc.Synopsis(strings.Replace(m.Description, "\n   ", "\n", -1))
 }
 
 func (m method) signature() string {
@@ -112,6 +117,7 @@ func toServiceMethod(pkg *loader.PackageInfo, n ast.Node) *method {
 		Service:     ident.Name,
 		Name:        decl.Name.String(),
 		Description: formatDescription(decl.Name.String(), decl.Doc.Text()),
+		Verb:        requestVerb(decl),
 	}
 
 	// Extract (name, type) pairs of method arguments
@@ -135,6 +141,27 @@ func toServiceMethod(pkg *loader.PackageInfo, n ast.Node) *method {
 	return m
 }
 
+// requestVerb finds the HTTP method of the request sent by a service method,
+// e.g. DELETE for s.client.NewRequest("DELETE", u, nil)
+func requestVerb(decl *ast.FuncDecl) string {
+	var verb string
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok || verb != "" || len(call.Args) == 0 {
+			return verb == ""
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok || !strings.HasPrefix(sel.Sel.Name, "New") || !strings.HasSuffix(sel.Sel.Name, "Request") {
+			return true
+		}
+		if lit, ok := call.Args[0].(*ast.BasicLit); ok && lit.Kind == token.STRING {
+			verb, _ = strconv.Unquote(lit.Value)
+		}
+		return true
+	})
+	return verb
+}
+
 func toStructTypeInfo(pkg *loader.PackageInfo, n ast.Node) (string, map[string]flag) {
 	spec, ok := n.(*ast.TypeSpec)
 	if !ok {
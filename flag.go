@@ -64,11 +64,11 @@ func (f flag) Accessor() string {
 	case "*[]string":
 		return fmt.Sprintf(`stringSlicePointer(c.StringSlice("%s"))`, dasherize(f.Name))
 	case "time.Time":
-		return fmt.Sprintf(`now.MustParse(c.String("%s"))`, dasherize(f.Name))
+		return fmt.Sprintf(`timeFlag(c, "%s")`, dasherize(f.Name))
 	case "*time.Time":
-		return fmt.Sprintf(`timePointer(now.MustParse(c.String("%s")))`, dasherize(f.Name))
+		return fmt.Sprintf(`timePointer(timeFlag(c, "%s"))`, dasherize(f.Name))
 	case "*github.Timestamp":
-		return fmt.Sprintf(`&github.Timestamp{now.MustParse(c.String("%s"))}`, dasherize(f.Name))
+		return fmt.Sprintf(`&github.Timestamp{Time: timeFlag(c, "%s")}`, dasherize(f.Name))
 	default:
 		log.Println("no accessor for flag type " + f.Typ)
 		return ""
@@ -9,6 +9,7 @@ import (
 	"path"
 	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 	"text/template"
 	"unicode"
@@ -58,21 +59,39 @@ func (c command) Flags() []flag {
 		case strings.HasPrefix(arg.Typ, "*github."):
 			typeName := strings.TrimPrefix(arg.Typ, "*github.")
 			c.flags = append(c.flags, flagSet(typeName, types[typeName])...)
+			c.flags = append(c.flags, flag{
+				Typ:   "string",
+				Name:  "bodyFile",
+				Usage: "JSON or YAML file holding the " + typeName + ", - to read from stdin. Flags take precedence over the file",
+			})
+		case strings.HasPrefix(arg.Typ, "[]github."):
+			c.flags = append(c.flags, sliceFlags(arg)...)
 		default:
 			log.Println("unimplemented arg type: ", arg.Typ)
 		}
 	}
 
+	if isDestructive(c.Method) {
+		c.flags = append(c.flags, flag{Typ: "bool", Name: "yes", Usage: "Don't ask for confirmation"})
+	}
+
 	return c.flags
 }
 
 func (c command) Usage() string {
 	var usage bytes.Buffer
 	usage.WriteString(dasherize(c.Method.Name) + " ")
-	for _, arg := range c.Method.Args {
+	for i, arg := range c.Method.Args {
 		if arg.Typ != "string" && arg.Typ != "int" && arg.Typ != "*os.File" {
 			continue
 		}
+		// The owner and repository can be inferred
+		if hasRepoArgs(c.Method) && i < 2 {
+			if i == 0 {
+				usage.WriteString("[<owner> <repo>] ")
+			}
+			continue
+		}
 		usage.WriteString("<" + dasherize(arg.Name) + "> ")
 	}
 
@@ -91,29 +110,82 @@ func (c command) UsageCount() int {
 	return count
 }
 
+func (c command) Destructive() bool {
+	return isDestructive(c.Method)
+}
+
+// ConfirmValue is what the user types to confirm a destructive command: the
+// name of the repository, or else the last argument, such as the user removed
+// from an organization.
+func (c command) ConfirmValue() string {
+	value := strconv.Quote(dasherize(c.Method.Name))
+	for _, arg := range c.Method.Args {
+		switch {
+		case isRepoArg(arg):
+			return arg.Name
+		case arg.Typ == "string":
+			value = arg.Name
+		case arg.Typ == "int":
+			value = "strconv.Itoa(" + arg.Name + ")"
+		}
+	}
+	return value
+}
+
+// PositionalArgs is the expression holding the arguments of the command. The
+// owner and repository of repository methods are optional, so only the other
+// arguments are required.
+func (c command) PositionalArgs() string {
+	if hasRepoArgs(c.Method) {
+		return fmt.Sprintf("repoArgs(c, %d)", c.UsageCount()-2)
+	}
+	return "c.Args()"
+}
+
 func (c command) SetupArgs() string {
 	var setup []string
 	for i, arg := range c.Method.Args {
 		switch {
 		case arg.Typ == "int":
-			setup = append(setup, fmt.Sprintf("%s, err := strconv.Atoi(c.Args().Get(%d))", arg.Name, i), "check(err)")
+			setup = append(setup, fmt.Sprintf("%s, err := strconv.Atoi(args.Get(%d))", arg.Name, i), "check(err)")
 		case arg.Typ == "bool":
 			setup = append(setup, fmt.Sprintf(`%s := c.Bool("%s")`+"\n", arg.Name, dasherize(arg.Name)))
 		case arg.Typ == "string":
-			setup = append(setup, fmt.Sprintf("%s := c.Args().Get(%d)", arg.Name, i))
+			setup = append(setup, fmt.Sprintf("%s := args.Get(%d)", arg.Name, i))
 		case arg.Typ == "*os.File":
-			setup = append(setup, fmt.Sprintf("%s, err := os.Open(c.Args().Get(%d))", arg.Name, i), "check(err)")
+			setup = append(setup, fmt.Sprintf("%s, err := os.Open(args.Get(%d))", arg.Name, i), "check(err)")
+		case arg.Typ == "time.Time":
+			// The API assumes the current time when these are omitted
+			setup = append(setup,
+				fmt.Sprintf("%s := time.Now()", arg.Name),
+				fmt.Sprintf(`if c.IsSet("%s") {`, dasherize(arg.Name)),
+				fmt.Sprintf(`%s = timeFlag(c, "%s")`, arg.Name, dasherize(arg.Name)),
+				"}",
+			)
 		case strings.HasPrefix(arg.Typ, "*github."):
 			typeName := strings.TrimPrefix(arg.Typ, "*github.")
-			typeInfo := types[typeName]
-			setup = append(setup, fmt.Sprintf("%s := &github.%s{", arg.Name, typeName))
-			for _, flag := range flagSet(typeName, typeInfo) {
-				if _, ok := typeInfo[flag.Name]; !ok {
-					continue // Ignore flags that didn't come from the type definition
-				}
-				setup = append(setup, fmt.Sprintf("%s: %s,", flag.Name, flag.Accessor()))
+			setup = append(setup,
+				fmt.Sprintf("%s := &github.%s{}", arg.Name, typeName),
+				fmt.Sprintf(`check(decodeFile(c.String("body-file"), %s))`, arg.Name),
+			)
+			// Only send what the user asked for, so that edits are partial updates
+			for _, flag := range fieldFlags(typeName) {
+				setup = append(setup,
+					fmt.Sprintf(`if c.IsSet("%s") {`, dasherize(flag.Name)),
+					fmt.Sprintf("%s.%s = %s", arg.Name, flag.Name, flag.Accessor()),
+					"}",
+				)
+			}
+		case strings.HasPrefix(arg.Typ, "[]github."):
+			typeName := strings.TrimPrefix(arg.Typ, "[]github.")
+			setup = append(setup,
+				fmt.Sprintf("var %s %s", arg.Name, arg.Typ),
+				fmt.Sprintf(`check(decodeFile(c.String("%s-file"), &%s))`, dasherize(arg.Name), arg.Name),
+			)
+			if fields, ok := compactFormats[typeName]; ok {
+				setup = append(setup, fmt.Sprintf(`check(appendFromSpecs(&%s, c.StringSlice("%s"), "%s"))`,
+					arg.Name, dasherize(singular(arg.Name)), strings.Join(fields, `", "`)))
 			}
-			setup = append(setup, "}")
 		default:
 			isFlag := false
 			for _, flag := range c.Flags() {
@@ -136,6 +208,23 @@ func (c command) SetupArgs() string {
 	return strings.Join(setup, "\n")
 }
 
+// ResultType is the go-github type printed by the command, without slice or
+// pointer markers, e.g. Issue for both *github.Issue and []github.Issue. For
+// search commands, it is the type of the items found.
+func (c command) ResultType() string {
+	typeName := strings.TrimPrefix(strings.TrimLeft(c.Method.Returns[0], "[]*"), "github.")
+	if items, ok := searchItems(typeName); ok {
+		return strings.TrimPrefix(items.Typ, "[]github.")
+	}
+	return typeName
+}
+
+// SearchItemsField is the field of a search result holding the items found
+func (c command) SearchItemsField() string {
+	items, _ := searchItems(strings.TrimPrefix(c.Method.Returns[0], "*github."))
+	return items.Name
+}
+
 func (c command) ArgList() string {
 	var list []string
 	for _, arg := range c.Method.Args {
@@ -145,6 +234,21 @@ func (c command) ArgList() string {
 	return strings.Join(list, ", ")
 }
 
+// PagedArgList is the argument list for a page fetched by the paginator, which
+// passes a copy of the list options
+func (c command) PagedArgList() string {
+	var list []string
+	for _, arg := range c.Method.Args {
+		if isListOptions(arg) {
+			list = append(list, "&"+arg.Name)
+		} else {
+			list = append(list, arg.Name)
+		}
+	}
+
+	return strings.Join(list, ", ")
+}
+
 var (
 	methods []method
 	types   map[string]map[string]flag
@@ -216,12 +320,17 @@ func main() {
 
 func toSubCommand(m method) *command {
 	cmd := &command{Method: m, Tmpl: notImplementedTmpl}
-	if isSimpleListMethod(m) {
+	switch {
+	case isSimpleListMethod(m):
 		cmd.Tmpl = listTmpl
-	} else {
-		if len(m.Returns) <= 3 {
-			cmd.Tmpl = singleTmpl
-		}
+	case isSearchMethod(m):
+		cmd.Tmpl = searchTmpl
+	case isFileOrDirMethod(m):
+		cmd.Tmpl = fileOrDirTmpl
+	case m.Returns[0] == "io.ReadCloser":
+		cmd.Tmpl = readerTmpl
+	case len(m.Returns) <= 3:
+		cmd.Tmpl = singleTmpl
 	}
 
 	return cmd
@@ -311,13 +420,110 @@ func flagSet(typeName string, typeInfo map[string]flag) []flag {
 			}
 		}
 	}
+	// Fields come in random order from the map, sort them so that the output
+	// doesn't change from one run to the next
+	sort.Sort(byFlagName(flags))
+
 	if typeName == "ListOptions" {
-		flags = append(flags, flag{Typ: "bool", Name: "all", Usage: `For paginated result sets, fetch all remaining pages starting at "page"`})
+		flags = append(flags,
+			flag{Typ: "bool", Name: "all", Usage: `For paginated result sets, fetch all remaining pages starting at "page"`},
+			flag{Typ: "int", Name: "limit", Usage: "Stop after this many items, fetching the following pages as needed"},
+		)
+	}
+
+	return flags
+}
+
+type byFlagName []flag
+
+func (f byFlagName) Len() int           { return len(f) }
+func (f byFlagName) Less(i, j int) bool { return dasherize(f[i].Name) < dasherize(f[j].Name) }
+func (f byFlagName) Swap(i, j int)      { f[i], f[j] = f[j], f[i] }
+
+// fieldFlags are the flags of typeName which map directly to one of its fields,
+// including the fields promoted from embedded structs such as ListOptions
+func fieldFlags(typeName string) []flag {
+	typeInfo := types[typeName]
+
+	var flags []flag
+	for _, f := range flagSet(typeName, typeInfo) {
+		if _, ok := typeInfo[f.Name]; ok {
+			flags = append(flags, f)
+		}
+	}
+
+	// Embedded pointers may be nil, only promote fields of embedded values
+	if embedded, ok := typeInfo[""]; ok && strings.HasPrefix(embedded.Typ, "github.") {
+		flags = append(flags, fieldFlags(strings.TrimPrefix(embedded.Typ, "github."))...)
+	}
+
+	return flags
+}
+
+// Struct types that can be given as a colon separated list of fields through a
+// repeatable flag, in addition to a JSON or YAML file
+var compactFormats = map[string][]string{
+	"TreeEntry": {"Path", "Mode", "Type", "SHA"},
+}
+
+// sliceFlags are the flags used to fill a []github.X argument
+func sliceFlags(arg argument) []flag {
+	flags := []flag{{
+		Typ:   "string",
+		Name:  arg.Name + "File",
+		Usage: "JSON or YAML file holding the " + dasherize(arg.Name) + ", - to read from stdin",
+	}}
+
+	typeName := strings.TrimPrefix(arg.Typ, "[]github.")
+	if fields, ok := compactFormats[typeName]; ok {
+		flags = append(flags, flag{
+			Typ:   "[]string",
+			Name:  singular(arg.Name),
+			Usage: "Add an item as " + strings.ToLower(strings.Join(fields, ":")) + ", can be repeated",
+		})
 	}
 
 	return flags
 }
 
+func singular(name string) string {
+	switch {
+	case strings.HasSuffix(name, "ies"):
+		return strings.TrimSuffix(name, "ies") + "y"
+	case strings.HasSuffix(name, "s"):
+		return strings.TrimSuffix(name, "s")
+	default:
+		return name
+	}
+}
+
+// hasRepoArgs tells whether the first arguments of a method are the owner and
+// name of a repository, which can then be inferred from the git checkout.
+func hasRepoArgs(m method) bool {
+	args := m.Args
+	return len(args) >= 2 && args[0].Name == "owner" && args[0].Typ == "string" && isRepoArg(args[1])
+}
+
+// isRepoArg tells whether arg is the name of a repository, which go-github
+// spells repo or repository
+func isRepoArg(arg argument) bool {
+	return (arg.Name == "repo" || arg.Name == "repository") && arg.Typ == "string"
+}
+
+// isDestructive tells whether a method deletes or revokes something, so that
+// the command asks for confirmation. Methods undoing an action, such as
+// Unstar, aren't worth the question.
+func isDestructive(m method) bool {
+	switch {
+	case strings.HasPrefix(m.Name, "Delete"), strings.HasPrefix(m.Name, "Remove"):
+		return true
+	case m.Name == "DemoteSiteAdmin", m.Name == "Suspend":
+		return true
+	default:
+		return m.Verb == "DELETE" && !strings.HasPrefix(m.Name, "Un")
+	}
+}
+
 func isSimpleListMethod(m method) bool {
 	if !strings.HasPrefix(m.Returns[0], "[]") {
 		return false
@@ -325,21 +531,60 @@ func isSimpleListMethod(m method) bool {
 
 	re := regexp.MustCompile(".*List.*Options")
 	for _, arg := range m.Args {
-		typeName := strings.TrimPrefix(arg.Typ, "*github.")
-		if re.MatchString(typeName) {
-			if typeName == "ListOptions" {
-				return true
-			}
-			for _, f := range types[typeName] {
-				if f.Typ == "github.ListOptions" {
-					return true
-				}
-			}
+		if re.MatchString(arg.Typ) && isListOptions(arg) {
+			return true
+		}
+	}
+	return false
+}
+
+// Search methods return a single result wrapping one page of items
+func isSearchMethod(m method) bool {
+	if _, ok := searchItems(strings.TrimPrefix(m.Returns[0], "*github.")); !ok {
+		return false
+	}
+	for _, arg := range m.Args {
+		if isListOptions(arg) {
+			return true
 		}
 	}
 	return false
 }
 
+// searchItems finds the field holding the items of a search result type such
+// as RepositoriesSearchResult
+func searchItems(typeName string) (flag, bool) {
+	if !strings.HasSuffix(typeName, "SearchResult") {
+		return flag{}, false
+	}
+	for _, f := range types[typeName] {
+		if strings.HasPrefix(f.Typ, "[]github.") {
+			return f, true
+		}
+	}
+	return flag{}, false
+}
+
+// isListOptions tells whether arg is ListOptions or an options struct embedding it
+func isListOptions(arg argument) bool {
+	typeName := strings.TrimPrefix(arg.Typ, "*github.")
+	if typeName == "ListOptions" {
+		return true
+	}
+	for _, f := range types[typeName] {
+		if f.Typ == "github.ListOptions" {
+			return true
+		}
+	}
+	return false
+}
+
+// Methods returning either a single item or a list of them, like GetContents
+// does for files and directories
+func isFileOrDirMethod(m method) bool {
+	return len(m.Returns) == 4 && strings.HasPrefix(m.Returns[0], "*") && m.Returns[1] == "[]"+m.Returns[0]
+}
+
 func isExported(typ string) bool {
 	parts := strings.Split(typ, ".")
 	last := parts[len(parts)-1]
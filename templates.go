@@ -59,21 +59,26 @@ var singleTmpl = template.Must(template.New("single").Funcs(funcMap).Parse(
     {{end}}
   },
   Action: func(c *cli.Context) { {{if gt .UsageCount 0}}
-    if len(c.Args()) < {{.UsageCount}} {
+    args := {{.PositionalArgs}}
+    if len(args) < {{.UsageCount}} {
       showHelp(c, "{{.Method.Name | dasherize}}", "{{.Usage}}")
     }
 
     {{end}}
     {{.SetupArgs}}
 
+    {{if .Destructive}}
+    confirm(c, "{{.Method.Name | dasherize}}", {{.ConfirmValue}})
+
+    {{end}}
     {{if eq (len .Method.Returns) 3}}
     result, res, err := app.gh.{{.Method.Service | pointer}}.{{.Method.Name}}({{.ArgList}})
-    checkResponse(res.Response, err)
-    fmt.Printf("%# v", pretty.Formatter(result))
+    checkResponse(res, err)
+    render(c, "{{.ResultType}}", result)
     {{else}}
     {{if eq (index .Method.Returns 0) "*github.Response"}}
     res, err := app.gh.{{.Method.Service | pointer}}.{{.Method.Name}}({{.ArgList}})
-    checkResponse(res.Response, err)
+    checkResponse(res, err)
     {{else}}
     _, err := app.gh.{{.Method.Service | pointer}}.{{.Method.Name}}({{.ArgList}})
     check(err)
@@ -92,27 +97,102 @@ var listTmpl = template.Must(template.New("list").Funcs(funcMap).Parse(
     {{end}}
   },
   Action: func(c *cli.Context) { {{if gt .UsageCount 0}}
-    if len(c.Args()) < {{.UsageCount}} {
+    args := {{.PositionalArgs}}
+    if len(args) < {{.UsageCount}} {
       showHelp(c, "{{.Method.Name | dasherize}}", "{{.Usage}}")
     }
 
     {{end}}
     {{.SetupArgs}}
 
-    var items {{index .Method.Returns 0}}
+    if opt.PerPage == 0 {
+      opt.PerPage = app.profile.PerPage
+    }
+
+    paginate(c, "{{.ResultType}}", func(page int) (interface{}, *github.Response, error) {
+      opt := *opt
+      opt.Page = page
+      return app.gh.{{.Method.Service | pointer}}.{{.Method.Name}}({{.PagedArgList}})
+    })
+  },
+},`))
+
+var searchTmpl = template.Must(template.New("search").Funcs(funcMap).Parse(
+	`cli.Command{
+  Name:  "{{.Method.Name | dasherize}}",
+  Usage: ` + "`" + `{{.Method.Usage}}` + "`" + `,
+  Description: ` + "`" + `{{.Method.Description}}` + "`" + `,
+  Flags: []cli.Flag{
+    {{range .Flags}}{{.Declaration}},
+    {{end}}
+  },
+  Action: func(c *cli.Context) { {{if gt .UsageCount 0}}
+    args := {{.PositionalArgs}}
+    if len(args) < {{.UsageCount}} {
+      showHelp(c, "{{.Method.Name | dasherize}}", "{{.Usage}}")
+    }
 
-    for {
-      page, res, err := app.gh.{{.Method.Service | pointer}}.{{.Method.Name}}({{.ArgList}})
-      checkResponse(res.Response, err)
+    {{end}}
+    {{.SetupArgs}}
 
-      items = append(items, page...)
-      if res.NextPage == 0 || !c.Bool("all") {
-        break
-      }
-      opt.Page = res.NextPage
+    if opt.PerPage == 0 {
+      opt.PerPage = app.profile.PerPage
     }
 
-    fmt.Printf("%# v", pretty.Formatter(items))
+    paginateSearch(c, "{{.ResultType}}", "{{.SearchItemsField}}", func(page int) (interface{}, *github.Response, error) {
+      opt := *opt
+      opt.Page = page
+      return app.gh.{{.Method.Service | pointer}}.{{.Method.Name}}({{.PagedArgList}})
+    })
+  },
+},`))
+
+var fileOrDirTmpl = template.Must(template.New("file-or-dir").Funcs(funcMap).Parse(
+	`cli.Command{
+  Name:  "{{.Method.Name | dasherize}}",
+  Usage: ` + "`" + `{{.Method.Usage}}` + "`" + `,
+  Description: ` + "`" + `{{.Method.Description}}` + "`" + `,
+  Flags: []cli.Flag{
+    {{range .Flags}}{{.Declaration}},
+    {{end}}cli.BoolFlag{Name: ` + "`raw`" + `, Usage: ` + "`Write the file bytes to stdout as is`" + `},
+  },
+  Action: func(c *cli.Context) { {{if gt .UsageCount 0}}
+    args := {{.PositionalArgs}}
+    if len(args) < {{.UsageCount}} {
+      showHelp(c, "{{.Method.Name | dasherize}}", "{{.Usage}}")
+    }
+
+    {{end}}
+    {{.SetupArgs}}
+
+    file, dir, res, err := app.gh.{{.Method.Service | pointer}}.{{.Method.Name}}({{.ArgList}})
+    checkResponse(res, err)
+    renderFileOrDir(c, file, dir)
+  },
+},`))
+
+var readerTmpl = template.Must(template.New("reader").Funcs(funcMap).Parse(
+	`cli.Command{
+  Name:  "{{.Method.Name | dasherize}}",
+  Usage: ` + "`" + `{{.Method.Usage}}` + "`" + `,
+  Description: ` + "`" + `{{.Method.Description}}` + "`" + `,
+  Flags: []cli.Flag{
+    {{range .Flags}}{{.Declaration}},
+    {{end}}cli.StringFlag{Name: ` + "`out`" + `, Usage: ` + "`Write to this file instead of stdout`" + `},
+  },
+  Action: func(c *cli.Context) { {{if gt .UsageCount 0}}
+    args := {{.PositionalArgs}}
+    if len(args) < {{.UsageCount}} {
+      showHelp(c, "{{.Method.Name | dasherize}}", "{{.Usage}}")
+    }
+
+    {{end}}
+    {{.SetupArgs}}
+
+    app.stream()
+    body, err := app.gh.{{.Method.Service | pointer}}.{{.Method.Name}}({{.ArgList}})
+    check(err)
+    download(c, body)
   },
 },`))
 